@@ -4,12 +4,93 @@
 
 package goaoc
 
+import (
+	"iter"
+	"time"
+)
+
 // Challenge represents the function signature expected for both parts of a given challenge.
-// Each Challenge function receives a string input (raw challenge data) and returns an int result.
-type Challenge func(string) int
+// Each Challenge function receives a string input (raw challenge data) and returns a result of type T.
+// T may be any comparable type, so answers such as int, uint64 or string are returned without conversion.
+// Answers exceeding 64 bits can be returned as a *big.Int.
+//
+// Example:
+//
+//	var partOne Challenge[string] = func(input string) string { return "CMZ" }
+type Challenge[T comparable] func(string) T
+
+// ChallengeE is the error-returning variant of Challenge. It is meant for solutions that can fail,
+// e.g. when the input cannot be parsed, so the failure is returned by RunE instead of panicking.
+//
+// Example:
+//
+//	var partOne ChallengeE[int] = func(input string) (int, error) { return strconv.Atoi(input) }
+type ChallengeE[T comparable] func(string) (T, error)
+
+// StreamChallenge is the streaming variant of Challenge, for inputs too large to be held in memory, such as
+// generated stress tests. It receives the lines of the input, without their line breaks, as they are read.
+// Run it with RunStream.
+//
+// Example:
+//
+//	var partOne StreamChallenge[int] = func(lines iter.Seq[string]) int {
+//	    count := 0
+//	    for range lines {
+//	        count++
+//	    }
+//	    return count
+//	}
+type StreamChallenge[T comparable] func(lines iter.Seq[string]) T
+
+// withError adapts a Challenge into a ChallengeE that never fails.
+// A nil Challenge stays nil, so it is still reported as not implemented.
+func (c Challenge[T]) withError() ChallengeE[T] {
+	if c == nil {
+		return nil
+	}
+
+	return func(input string) (T, error) {
+		return c(input), nil
+	}
+}
+
+// Solver groups both parts of a challenge into a single type, so a day's solution can keep state,
+// such as a parsed representation of the input, shared by both parts.
+// The answer type T is inferred from the methods when the Solver is passed to RunSolver.
+//
+// Example:
+//
+//	type day07 struct{}
+//
+//	func (d day07) Part1(input string) int { return len(input) }
+//	func (d day07) Part2(input string) int { return len(input) * 2 }
+type Solver[T comparable] interface {
+	// Part1 solves the first part of the challenge.
+	Part1(input string) T
+
+	// Part2 solves the second part of the challenge.
+	Part2(input string) T
+}
+
+// Result holds the outcome of a challenge execution: the computed answer, the part executed and
+// how long the challenge function took to produce the answer.
+type Result[T comparable] struct {
+	// Answer is the value returned by the executed Challenge.
+	Answer T
+
+	// Part is the part of the challenge that was executed.
+	Part Part
+
+	// Duration is the time spent inside the Challenge function, excluding I/O.
+	Duration time.Duration
+
+	// Metadata describes the input and environment that produced the answer.
+	Metadata Metadata
+}
 
 // Part is an enumeration representing which part of the Advent of Code challenge to execute.
-// Valid values are 1 and 2, corresponding to the problem statement's divisions.
+// Valid values are 1 and 2, corresponding to the problem statement's divisions, or 1 to N for
+// challenges with N parts run with RunParts.
 type Part int
 
 // NewPart constructs a Part from an integer. Returns an error if the part number is not valid (not 1 or 2).
@@ -21,8 +102,18 @@ type Part int
 //	    log.Fatal(err) // 'err' will contain 'invalid part' message if not 1 or 2
 //	}
 func NewPart(p int) (Part, error) {
-	if p != 1 && p != 2 {
-		return Part(0), InvalidPartError{Part: p}
+	return NewPartOf(p, 2)
+}
+
+// NewPartOf constructs a Part from an integer for a challenge with the given number of parts.
+// Returns an error if the part number is not between 1 and parts.
+//
+// Example:
+//
+//	part, err := NewPartOf(3, 3)
+func NewPartOf(p, parts int) (Part, error) {
+	if p < 1 || p > parts {
+		return Part(0), InvalidPartError{Part: p, Parts: parts}
 	}
 
 	return Part(p), nil
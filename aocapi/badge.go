@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"fmt"
+	"html"
+	"unicode/utf8"
+
+	"github.com/hvpaiva/goaoc/internal/atomicfile"
+)
+
+const (
+	// badgeCharWidth is the approximate width, in pixels, of a character of the 11px Verdana text of a badge.
+	badgeCharWidth = 7
+
+	// badgePadding is the horizontal padding, in pixels, around each text of a badge.
+	badgePadding = 10
+)
+
+// badgeTemplate is an SVG badge in the flat style of shields.io. Its arguments are the total width, the
+// accessible text, the width of the label, the width and color of the message, the centers of the label
+// and the message, and the label and the message.
+const badgeTemplate = `<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20" role="img" aria-label="%[2]s">` +
+	`<title>%[2]s</title>` +
+	`<linearGradient id="s" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>` +
+	`<clipPath id="r"><rect width="%[1]d" height="20" rx="3" fill="#fff"/></clipPath>` +
+	`<g clip-path="url(#r)"><rect width="%[3]d" height="20" fill="#555"/><rect x="%[3]d" width="%[4]d" height="20" fill="%[5]s"/>` +
+	`<rect width="%[1]d" height="20" fill="url(#s)"/></g>` +
+	`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">` +
+	`<text x="%[6]d" y="14">%[8]s</text><text x="%[7]d" y="14">%[9]s</text></g></svg>` + "\n"
+
+// TotalStars returns the number of stars of the event: two for each day, with 25 days until 2024 and 12 since 2025.
+func (p Progress) TotalStars() int {
+	if p.Year >= 2025 {
+		return 2 * 12
+	}
+
+	return 2 * 25
+}
+
+// Badge returns an SVG badge of the progress in the flat style of shields.io, e.g. "AoC 2024 | 38/50 ★",
+// green while in progress and gold once every star is earned, to be embedded in the README of a solutions
+// repository.
+//
+// Example:
+//
+//	progress, err := client.Progress(ctx, 2024)
+//	if err == nil {
+//	    http.ServeContent(w, r, "badge.svg", time.Now(), bytes.NewReader(progress.Badge()))
+//	}
+func (p Progress) Badge() []byte {
+	label := fmt.Sprintf("AoC %d", p.Year)
+	message := fmt.Sprintf("%d/%d ★", p.Stars(), p.TotalStars())
+
+	color := "#009900"
+
+	switch stars := p.Stars(); {
+	case stars == 0:
+		color = "#9f9f9f"
+	case stars >= p.TotalStars():
+		color = "#d4a017"
+	}
+
+	labelWidth := utf8.RuneCountInString(label)*badgeCharWidth + 2*badgePadding
+	messageWidth := utf8.RuneCountInString(message)*badgeCharWidth + 2*badgePadding
+
+	return fmt.Appendf(nil, badgeTemplate,
+		labelWidth+messageWidth, html.EscapeString(label+": "+message),
+		labelWidth, messageWidth, color,
+		labelWidth/2, labelWidth+messageWidth/2,
+		html.EscapeString(label), html.EscapeString(message))
+}
+
+// WriteBadge writes the Badge of the progress to the file at path, readable by everyone, replacing it atomically
+// so a page serving the badge never reads a partial file. The directory of path must exist.
+//
+// Example:
+//
+//	err := progress.WriteBadge("docs/aoc-2024.svg")
+func (p Progress) WriteBadge(path string) error {
+	return atomicfile.Write(path, p.Badge(), 0o644)
+}
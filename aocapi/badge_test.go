@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+func TestBadge(t *testing.T) {
+	testCases := []struct {
+		name     string
+		progress aocapi.Progress
+		text     string
+		color    string
+	}{
+		{
+			name:     "InProgress",
+			progress: aocapi.Progress{Year: 2024, Days: map[int]int{1: 2, 2: 1, 3: 0}},
+			text:     "AoC 2024: 3/50 ★",
+			color:    "#009900",
+		},
+		{
+			name:     "None",
+			progress: aocapi.Progress{Year: 2023},
+			text:     "AoC 2023: 0/50 ★",
+			color:    "#9f9f9f",
+		},
+		{
+			name:     "Complete",
+			progress: aocapi.Progress{Year: 2025, Days: map[int]int{1: 2, 2: 2, 3: 2, 4: 2, 5: 2, 6: 2, 7: 2, 8: 2, 9: 2, 10: 2, 11: 2, 12: 2}},
+			text:     "AoC 2025: 24/24 ★",
+			color:    "#d4a017",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			badge := tc.progress.Badge()
+
+			for _, expected := range []string{`aria-label="` + tc.text + `"`, `fill="` + tc.color + `"`, "<svg ", "</svg>"} {
+				if !bytes.Contains(badge, []byte(expected)) {
+					t.Errorf("Expected the badge to contain %q, but got %s", expected, badge)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteBadge(t *testing.T) {
+	progress := aocapi.Progress{Year: 2024, Days: map[int]int{1: 2}}
+	path := filepath.Join(t.TempDir(), "badge.svg")
+
+	if err := progress.WriteBadge(path); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil || !bytes.Equal(content, progress.Badge()) {
+		t.Errorf("Expected the badge to be written, but got %s (err: %v)", content, err)
+	}
+
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o644 {
+		t.Errorf("Expected the badge to be readable by everyone, but got %v (err: %v)", info.Mode(), err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hvpaiva/goaoc/internal/atomicfile"
+)
+
+// cachedResponse is the body of a response cached by an HTTPClient, with when it was received.
+type cachedResponse struct {
+	body       []byte
+	receivedAt time.Time
+}
+
+// getCached works like get, but returns the body cached in memory or in CacheDir under key, a relative file
+// path, when it was received less than ttl ago. A body is only cached once accepted by check, so an error page
+// is not served from the cache. The lock is held during the request, so concurrent calls send a single request.
+func (c *HTTPClient) getCached(ctx context.Context, path, key string, ttl time.Duration, check func(body []byte) error) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.cache[key]; ok && time.Since(cached.receivedAt) < ttl {
+		return cached.body, nil
+	}
+
+	if c.CacheDir != "" {
+		cached, err := c.loadCache(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if time.Since(cached.receivedAt) < ttl {
+			c.store(key, cached)
+
+			return cached.body, nil
+		}
+	}
+
+	body, err := c.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := check(body); err != nil {
+		return nil, err
+	}
+
+	c.store(key, cachedResponse{body: body, receivedAt: time.Now()})
+
+	if c.CacheDir != "" {
+		path := filepath.Join(c.CacheDir, key)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return nil, err
+		}
+
+		// Leaderboards are private, so they are only readable by the current user.
+		if err := atomicfile.Write(path, body, 0o600); err != nil {
+			return nil, err
+		}
+	}
+
+	return body, nil
+}
+
+// store caches the response in memory under key.
+func (c *HTTPClient) store(key string, response cachedResponse) {
+	if c.cache == nil {
+		c.cache = make(map[string]cachedResponse)
+	}
+
+	c.cache[key] = response
+}
+
+// loadCache returns the response cached in CacheDir under key, received when the file was last modified.
+// A missing file is a response received at the zero time, so it is always expired.
+func (c *HTTPClient) loadCache(key string) (cachedResponse, error) {
+	path := filepath.Join(c.CacheDir, key)
+
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cachedResponse{}, nil
+	}
+
+	if err != nil {
+		return cachedResponse{}, err
+	}
+
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return cachedResponse{}, err
+	}
+
+	return cachedResponse{body: body, receivedAt: info.ModTime()}, nil
+}
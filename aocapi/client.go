@@ -0,0 +1,359 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+// Package aocapi provides a typed client for adventofcode.com, authenticated by the session cookie of an
+// account: it fetches inputs and puzzle pages, submits answers and reads the private leaderboards and the
+// progress of the account. Every interaction is part of the Client interface, implemented by the HTTPClient
+// and, for tests, by the in-memory Fake.
+package aocapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hvpaiva/goaoc/internal/buildinfo"
+)
+
+// DefaultBaseURL is the URL of adventofcode.com.
+const DefaultBaseURL = "https://adventofcode.com"
+
+// ErrRequestFailed indicates that adventofcode.com answered a request with a status other than 200 OK.
+// It is returned wrapped with the status.
+var ErrRequestFailed = errors.New("adventofcode.com request failed")
+
+// ErrNotFound indicates that adventofcode.com answered a request with 404 Not Found, e.g. for the input of a
+// puzzle not unlocked yet. It is returned wrapped with ErrRequestFailed and the request.
+var ErrNotFound = errors.New("not found on adventofcode.com")
+
+// ErrSessionExpired indicates that adventofcode.com did not accept the session of a request, because it has
+// expired or is wrong: it redirected the request to its login page or asked to log in. Log in to
+// adventofcode.com in a browser and copy the new value of its session cookie from the developer tools.
+var ErrSessionExpired = errors.New("adventofcode.com session expired or invalid, " +
+	"log in to https://adventofcode.com and copy the new value of its session cookie from the developer tools of your browser")
+
+// ErrInvalidResponse indicates that adventofcode.com answered a request with a body that could not be decoded,
+// typically an HTML page served because the session has expired or cannot access the resource.
+// It is returned wrapped with the decoding error.
+var ErrInvalidResponse = errors.New("invalid adventofcode.com response")
+
+// Client is the interface of every interaction with adventofcode.com, so code using it can be tested with a
+// Fake instead of the HTTPClient talking to the real site.
+type Client interface {
+	// Input returns the input of the puzzle of the given year and day.
+	Input(ctx context.Context, year, day int) (string, error)
+
+	// Puzzle returns the HTML page of the puzzle of the given year and day, with the description of its parts.
+	Puzzle(ctx context.Context, year, day int) (string, error)
+
+	// Submit submits the answer of the given part of the puzzle of the given year and day, and returns the
+	// message of the response, such as "That's the right answer! ...".
+	Submit(ctx context.Context, year, day, part int, answer string) (string, error)
+
+	// Leaderboard returns the private leaderboard of the given event year and ID.
+	Leaderboard(ctx context.Context, year, id int) (Leaderboard, error)
+
+	// Progress returns the stars earned by the account on each day of the event of the given year.
+	Progress(ctx context.Context, year int) (Progress, error)
+
+	// Stars returns the number of stars earned by the account on each event, by year.
+	Stars(ctx context.Context) (map[int]int, error)
+}
+
+// HTTPClient is the Client talking to adventofcode.com on behalf of the account of a session cookie. Its zero
+// value uses the session of the environment, see Session. An HTTPClient is safe for concurrent use.
+//
+// Following the automation guidelines of Advent of Code, the requests of every HTTPClient of the process to
+// DefaultBaseURL are spaced out by at least RequestInterval, they identify goaoc and a contact in their
+// User-Agent, and a page answered with 404 Not Found, e.g. the input of a puzzle not unlocked yet, is not
+// requested again by the process for 15 minutes, or until the puzzle unlocks. In the minute following the unlock
+// of a puzzle, when the local clock may be ahead of adventofcode.com, a 404 Not Found of its pages is polled
+// every second instead, until the puzzle is available.
+type HTTPClient struct {
+	// BaseURL is the URL of adventofcode.com, e.g. replaced by the URL of a test server.
+	// DefaultBaseURL is used when empty.
+	BaseURL string
+
+	// Session is the session cookie of the account. When empty, the AOC_SESSION environment variable, shared
+	// by the tools of the Advent of Code ecosystem, is used, or else GOAOC_SESSION.
+	Session string
+
+	// UserAgent identifies the client to adventofcode.com. When empty, it identifies goaoc, its version and
+	// the Contact, following the automation guidelines of Advent of Code.
+	UserAgent string
+
+	// Contact, such as an e-mail address or the URL of a repository, lets the Advent of Code team reach out if
+	// the client misbehaves. The GOAOC_CONTACT environment variable is used when empty.
+	Contact string
+
+	// HTTP sends the requests. DefaultHTTPClient is used when nil.
+	HTTP *http.Client
+
+	// Retry sets how failed GET requests are retried. The zero value never retries. Answers are never retried,
+	// so they are not submitted twice.
+	Retry RetryPolicy
+
+	// CacheDir is the directory where responses are cached, so the polling guidance of Advent of Code is
+	// followed across processes. When empty, responses are only cached in memory, by the HTTPClient.
+	CacheDir string
+
+	mu    sync.Mutex
+	cache map[string]cachedResponse
+}
+
+var _ Client = (*HTTPClient)(nil)
+
+// DefaultHTTPClient sends the requests of an HTTPClient without HTTP client. Its connection timeouts make
+// requests fail fast without connectivity, instead of hanging, and it goes through the proxy of the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+var DefaultHTTPClient = &http.Client{
+	Timeout: time.Minute,
+	Transport: &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		DialContext:         (&net.Dialer{Timeout: 5 * time.Second}).DialContext,
+		TLSHandshakeTimeout: 5 * time.Second,
+	},
+}
+
+// notFoundTTL is how long a 404 Not Found response is remembered, see HTTPClient.
+const notFoundTTL = 15 * time.Minute
+
+// unlockWindow is how long after the unlock of a puzzle a 404 Not Found of its pages is expected, because the
+// local clock is ahead of adventofcode.com: the request is then polled every unlockPoll instead of failing.
+const unlockWindow = time.Minute
+
+// unlockPoll is the delay between the requests polled during the unlockWindow of a puzzle.
+var unlockPoll = time.Second
+
+// notFound holds the notFoundEntry of the URLs answered with 404 Not Found, so they are not requested again.
+var notFound sync.Map
+
+// notFoundEntry is the error of a URL answered with 404 Not Found, remembered until it expires.
+type notFoundEntry struct {
+	err     error
+	expires time.Time
+}
+
+// NewClient returns an HTTPClient for the account of the given session cookie, or of the session of the
+// environment when empty, see HTTPClient.Session.
+//
+// Example:
+//
+//	client := aocapi.NewClient("")
+func NewClient(session string) *HTTPClient {
+	return &HTTPClient{Session: session}
+}
+
+// request is a request to adventofcode.com.
+type request struct {
+	method string
+
+	// path is relative to the base URL.
+	path string
+
+	// form is the body of the request, unless it is nil.
+	form url.Values
+
+	// unlock is when the puzzle of the requested page unlocks, or zero for a page that is not of a puzzle.
+	unlock time.Time
+
+	// public is set for a page served without login, such as a puzzle, which asks visitors to log in: its body
+	// is not checked for a request to log in.
+	public bool
+}
+
+// get sends a GET request for the path, relative to the base URL, and returns the body of the response.
+func (c *HTTPClient) get(ctx context.Context, path string) ([]byte, error) {
+	return c.send(ctx, request{method: http.MethodGet, path: path})
+}
+
+// post sends a POST request of the form for the path, relative to the base URL, and returns the body of the response.
+func (c *HTTPClient) post(ctx context.Context, path string, form url.Values) ([]byte, error) {
+	return c.send(ctx, request{method: http.MethodPost, path: path, form: form})
+}
+
+// send sends the request and returns the body of the response, following the automation guidelines described
+// in HTTPClient. A status other than 200 OK fails with a StatusError wrapped in ErrRequestFailed, and a 404 Not
+// Found response with ErrNotFound too. A redirect, or a response asking to log in to a page that is not public,
+// fails with ErrSessionExpired.
+func (c *HTTPClient) send(ctx context.Context, req request) ([]byte, error) {
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	endpoint := baseURL + req.path
+
+	if value, ok := notFound.Load(endpoint); ok && req.method == http.MethodGet {
+		if entry, ok := value.(notFoundEntry); ok && time.Now().Before(entry.expires) {
+			return nil, entry.err
+		}
+	}
+
+	retry := c.Retry
+	if req.method != http.MethodGet {
+		retry = RetryPolicy{}
+	}
+
+	for {
+		var body []byte
+
+		err := retry.Do(ctx, func() error {
+			if baseURL == DefaultBaseURL {
+				if err := requests.wait(ctx); err != nil {
+					return err
+				}
+			}
+
+			var err error
+
+			body, err = c.do(ctx, req, endpoint)
+
+			return err
+		})
+
+		if !errors.Is(err, ErrNotFound) || req.method != http.MethodGet {
+			return body, err
+		}
+
+		now := time.Now()
+		if req.unlock.IsZero() || now.Before(req.unlock) || now.After(req.unlock.Add(unlockWindow)) {
+			expires := now.Add(notFoundTTL)
+			if now.Before(req.unlock) && req.unlock.Before(expires) {
+				expires = req.unlock
+			}
+
+			notFound.Store(endpoint, notFoundEntry{err: err, expires: expires})
+
+			return nil, err
+		}
+
+		if err := sleep(ctx, unlockPoll); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do sends the request once to endpoint, like send.
+func (c *HTTPClient) do(ctx context.Context, request request, endpoint string) ([]byte, error) {
+	var body io.Reader
+	if request.form != nil {
+		body = strings.NewReader(request.form.Encode())
+	}
+
+	method := request.method
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.session()})
+	req.Header.Set("User-Agent", c.userAgent())
+
+	if request.form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	client := DefaultHTTPClient
+	if c.HTTP != nil {
+		client = c.HTTP
+	}
+
+	// The requests of an expired session are redirected to the login page, so redirects are not followed.
+	noRedirects := *client
+	noRedirects.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := noRedirects.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	path := req.URL.Path
+
+	switch {
+	case resp.StatusCode/100 == 3:
+		return nil, fmt.Errorf("%w: %s %s redirected to %s", ErrSessionExpired, method, path, resp.Header.Get("Location"))
+	case resp.StatusCode == http.StatusOK:
+		// The body is checked against the Content-Length of the response, failing with io.ErrUnexpectedEOF.
+		body, err := io.ReadAll(resp.Body)
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("%w: %s %s: truncated response of %d bytes, expected %d: %w",
+				ErrRequestFailed, method, path, len(body), resp.ContentLength, err)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !request.public && asksToLogIn(body) {
+			return nil, fmt.Errorf("%w: %s %s", ErrSessionExpired, method, path)
+		}
+
+		return body, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %w: %s %s", ErrNotFound, ErrRequestFailed, method, path)
+	default:
+		// The beginning of the body explains the failure, such as a request to log in.
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if !request.public && asksToLogIn(body) {
+			return nil, fmt.Errorf("%w: %s %s: %s", ErrSessionExpired, method, path, resp.Status)
+		}
+
+		status := StatusError{Code: resp.StatusCode, Status: resp.Status, Body: string(body)}
+
+		return nil, fmt.Errorf("%w: %s %s: %w", ErrRequestFailed, method, path, status)
+	}
+}
+
+// asksToLogIn reports whether body is a response of adventofcode.com asking to log in, served instead of the
+// content of a request without a valid session. Public pages ask visitors to log in too, so they are not checked.
+func asksToLogIn(body []byte) bool {
+	return bytes.Contains(body, []byte("Please log in")) || bytes.Contains(body, []byte("please identify yourself"))
+}
+
+// session returns the session cookie of the requests, see Session.
+func (c *HTTPClient) session() string {
+	if c.Session != "" {
+		return c.Session
+	}
+
+	if session := os.Getenv("AOC_SESSION"); session != "" {
+		return session
+	}
+
+	return os.Getenv("GOAOC_SESSION")
+}
+
+// userAgent returns the User-Agent of the requests, e.g. "goaoc/v1.2.0 (+https://github.com/hvpaiva/goaoc; me@example.com)".
+func (c *HTTPClient) userAgent() string {
+	if c.UserAgent != "" {
+		return c.UserAgent
+	}
+
+	contact := c.Contact
+	if contact == "" {
+		contact = os.Getenv("GOAOC_CONTACT")
+	}
+
+	agent := fmt.Sprintf("goaoc/%s (+https://%s", buildinfo.Version(), buildinfo.ModulePath)
+	if contact != "" {
+		agent += "; " + contact
+	}
+
+	return agent + ")"
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/goaoc/internal/buildinfo"
+)
+
+func TestUserAgent(t *testing.T) {
+	t.Setenv("GOAOC_CONTACT", "")
+
+	testCases := []struct {
+		contact  string
+		expected string
+	}{
+		{"", "goaoc/" + buildinfo.Version() + " (+https://github.com/hvpaiva/goaoc)"},
+		{"me@example.com", "goaoc/" + buildinfo.Version() + " (+https://github.com/hvpaiva/goaoc; me@example.com)"},
+	}
+
+	for _, tc := range testCases {
+		if agent := (&HTTPClient{Contact: tc.contact}).userAgent(); agent != tc.expected {
+			t.Errorf("Expected User-Agent '%s', but got '%s'", tc.expected, agent)
+		}
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	limiter := &throttle{interval: 20 * time.Millisecond}
+	start := time.Now()
+
+	for range 3 {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected the operations to be spaced out by the interval, but they took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := (&throttle{interval: time.Hour, next: time.Now().Add(time.Hour)}).wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the wait to be cancelled, but got: %v", err)
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{Retries: 100, Backoff: time.Second}
+
+	for n, expected := range map[int]time.Duration{0: time.Second, 2: 4 * time.Second, 10: maxBackoff, 70: maxBackoff} {
+		if delay := policy.delay(n); delay < expected/2 || delay > expected {
+			t.Errorf("Expected the delay of retry %d to be between %s and %s, but got %s", n, expected/2, expected, delay)
+		}
+	}
+
+	for _, backoff := range []time.Duration{0, -time.Second} {
+		policy := RetryPolicy{Retries: 100, Backoff: backoff}
+
+		for _, n := range []int{0, 5, 70} {
+			if delay := policy.delay(n); delay != 0 {
+				t.Errorf("Expected no delay for retry %d with a %s backoff, but got %s", n, backoff, delay)
+			}
+		}
+	}
+}
+
+func TestNotFoundPolledAfterUnlock(t *testing.T) {
+	unlockPoll = time.Millisecond
+	t.Cleanup(func() { unlockPoll = time.Second })
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write([]byte("input"))
+	}))
+	t.Cleanup(server.Close)
+
+	client := &HTTPClient{BaseURL: server.URL, Session: "secret"}
+	req := request{method: http.MethodGet, path: "/2024/day/7/input", unlock: time.Now().Add(-time.Second)}
+
+	if body, err := client.send(context.Background(), req); err != nil || string(body) != "input" || requests != 3 {
+		t.Errorf("Expected the input after 3 requests, but got %q after %d (err: %v)", body, requests, err)
+	}
+
+	if _, ok := notFound.Load(server.URL + req.path); ok {
+		t.Error("Expected the 404 Not Found responses after the unlock not to be remembered")
+	}
+}
+
+func TestNotFoundExpires(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	client := &HTTPClient{BaseURL: server.URL, Session: "secret"}
+	unlock := time.Now().Add(time.Hour)
+	req := request{method: http.MethodGet, path: "/2024/day/7/input", unlock: unlock}
+
+	if _, err := client.send(context.Background(), req); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, but got: %v", err)
+	}
+
+	value, _ := notFound.Load(server.URL + req.path)
+	if entry, ok := value.(notFoundEntry); !ok || entry.expires.After(unlock) {
+		t.Errorf("Expected the 404 Not Found to be remembered until the unlock at the latest, but got %+v", value)
+	}
+
+	notFound.Store(server.URL+req.path, notFoundEntry{err: ErrNotFound, expires: time.Now()})
+
+	if _, err := client.send(context.Background(), req); !errors.Is(err, ErrNotFound) || requests != 2 {
+		t.Errorf("Expected the expired 404 Not Found to be requested again, but got %d requests (err: %v)", requests, err)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hvpaiva/goaoc/internal/atomicfile"
+)
+
+// ErrUnknownFormat indicates that Leaderboard.Export was given a path whose extension is neither .csv nor .json.
+// It is returned wrapped with the path.
+var ErrUnknownFormat = errors.New("unknown export format, expected .csv or .json")
+
+// StarRecord is a star earned by a member of a private leaderboard, as exported by Leaderboard.Records.
+type StarRecord struct {
+	MemberID int `json:"member_id"`
+
+	// Member is the name of the member, or "(anonymous user #ID)" for anonymous users, as on adventofcode.com.
+	Member string `json:"member"`
+
+	Day  int `json:"day"`
+	Part int `json:"part"`
+
+	// EarnedAt is when the star was earned.
+	EarnedAt time.Time `json:"earned_at"`
+
+	// LocalScore is the local score of the member on the leaderboard.
+	LocalScore int `json:"local_score"`
+}
+
+// Records returns every star earned by the members of the leaderboard, in the order they were earned.
+func (l Leaderboard) Records() []StarRecord {
+	type indexed struct {
+		StarRecord
+
+		index int64
+	}
+
+	var stars []indexed
+
+	for _, member := range l.Members {
+		name := member.Name
+		if name == "" {
+			name = fmt.Sprintf("(anonymous user #%d)", member.ID)
+		}
+
+		for day, parts := range member.Days {
+			for part, star := range parts {
+				stars = append(stars, indexed{
+					StarRecord: StarRecord{
+						MemberID:   member.ID,
+						Member:     name,
+						Day:        day,
+						Part:       part,
+						EarnedAt:   star.EarnedAt.Time,
+						LocalScore: member.LocalScore,
+					},
+					index: star.Index,
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(stars, func(a, b indexed) int {
+		return cmp.Or(a.EarnedAt.Compare(b.EarnedAt), cmp.Compare(a.index, b.index), cmp.Compare(a.MemberID, b.MemberID))
+	})
+
+	records := make([]StarRecord, len(stars))
+	for i, star := range stars {
+		records[i] = star.StarRecord
+	}
+
+	return records
+}
+
+// WriteCSV writes the Records of the leaderboard to w as CSV, with a header, e.g.:
+//
+//	member_id,member,day,part,earned_at,local_score
+//	1,alice,1,1,2024-12-01T05:13:20Z,10
+func (l Leaderboard) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"member_id", "member", "day", "part", "earned_at", "local_score"}); err != nil {
+		return err
+	}
+
+	for _, record := range l.Records() {
+		row := []string{
+			strconv.Itoa(record.MemberID),
+			record.Member,
+			strconv.Itoa(record.Day),
+			strconv.Itoa(record.Part),
+			record.EarnedAt.Format(time.RFC3339),
+			strconv.Itoa(record.LocalScore),
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// WriteJSON writes the Records of the leaderboard to w as an indented JSON array.
+func (l Leaderboard) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(l.Records())
+}
+
+// Export writes the Records of the leaderboard to the file at path, as CSV or JSON depending on its extension,
+// readable by everyone and replaced atomically, e.g. for a spreadsheet or a dashboard. The directory of path
+// must exist. Another extension fails with ErrUnknownFormat.
+//
+// Example:
+//
+//	err := leaderboard.Export("reports/leaderboard-2024.csv")
+func (l Leaderboard) Export(path string) error {
+	var buf bytes.Buffer
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		if err := l.WriteCSV(&buf); err != nil {
+			return err
+		}
+	case ".json":
+		if err := l.WriteJSON(&buf); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownFormat, path)
+	}
+
+	return atomicfile.Write(path, buf.Bytes(), 0o644)
+}
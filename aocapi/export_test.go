@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+func TestLeaderboardExport(t *testing.T) {
+	var leaderboard aocapi.Leaderboard
+	if err := json.Unmarshal([]byte(leaderboardJSON), &leaderboard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	leaderboard.Members[2] = aocapi.Member{ID: 2, LocalScore: 1, Days: map[int]map[int]aocapi.Star{
+		1: {1: {EarnedAt: leaderboard.Members[1].Days[1][1].EarnedAt, Index: 4}},
+	}}
+
+	dir := t.TempDir()
+
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{"leaderboard.csv", `member_id,member,day,part,earned_at,local_score
+2,(anonymous user #2),1,1,2024-12-01T05:13:20Z,1
+1,alice,1,1,2024-12-01T05:13:20Z,10
+1,alice,1,2,2024-12-01T05:30:00Z,10
+1,alice,2,1,2024-12-02T06:13:20Z,10
+`},
+		{"leaderboard.JSON", `[
+  {
+    "member_id": 2,
+    "member": "(anonymous user #2)",
+    "day": 1,
+    "part": 1,
+    "earned_at": "2024-12-01T05:13:20Z",
+    "local_score": 1
+  },
+  {
+    "member_id": 1,
+    "member": "alice",
+    "day": 1,
+    "part": 1,
+    "earned_at": "2024-12-01T05:13:20Z",
+    "local_score": 10
+  },
+  {
+    "member_id": 1,
+    "member": "alice",
+    "day": 1,
+    "part": 2,
+    "earned_at": "2024-12-01T05:30:00Z",
+    "local_score": 10
+  },
+  {
+    "member_id": 1,
+    "member": "alice",
+    "day": 2,
+    "part": 1,
+    "earned_at": "2024-12-02T06:13:20Z",
+    "local_score": 10
+  }
+]
+`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, tc.name)
+			if err := leaderboard.Export(path); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if content, err := os.ReadFile(path); err != nil || string(content) != tc.expected {
+				t.Errorf("Expected %q, but got %q (err: %v)", tc.expected, content, err)
+			}
+		})
+	}
+
+	if err := leaderboard.Export(filepath.Join(dir, "leaderboard.xlsx")); !errors.Is(err, aocapi.ErrUnknownFormat) {
+		t.Errorf("Expected ErrUnknownFormat, but got: %v", err)
+	}
+}
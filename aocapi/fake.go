@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"sync"
+)
+
+// PuzzleKey identifies the puzzle of a year and day.
+type PuzzleKey struct {
+	Year int
+	Day  int
+}
+
+// AnswerKey identifies a part of the puzzle of a year and day.
+type AnswerKey struct {
+	Year int
+	Day  int
+	Part int
+}
+
+// Submitted is an answer submitted to a Fake.
+type Submitted struct {
+	AnswerKey
+
+	Answer string
+}
+
+// Fake is an in-memory Client, for testing code that talks to adventofcode.com without reaching it. Its zero
+// value holds nothing: set the fields before use. Missing entries fail with ErrNotFound.
+// A Fake is safe for concurrent use once set up.
+//
+// Example:
+//
+//	fake := &aocapi.Fake{
+//	    Inputs:  map[aocapi.PuzzleKey]string{{Year: 2024, Day: 7}: "190: 10 19\n"},
+//	    Answers: map[aocapi.AnswerKey]string{{Year: 2024, Day: 7, Part: 1}: "190"},
+//	}
+type Fake struct {
+	// Inputs holds the input of each puzzle.
+	Inputs map[PuzzleKey]string
+
+	// Pages holds the HTML page of each puzzle.
+	Pages map[PuzzleKey]string
+
+	// Answers holds the right answer of each part. Submitting it answers "That's the right answer!", and
+	// submitting it again answers that the part is already complete.
+	Answers map[AnswerKey]string
+
+	// Leaderboards holds the private leaderboards, by ID.
+	Leaderboards map[int]Leaderboard
+
+	// Progresses holds the progress of the account, by year.
+	Progresses map[int]Progress
+
+	mu        sync.Mutex
+	submitted []Submitted
+	solved    map[AnswerKey]bool
+}
+
+var _ Client = (*Fake)(nil)
+
+// Input returns the input of the puzzle of the given year and day.
+func (f *Fake) Input(_ context.Context, year, day int) (string, error) {
+	input, ok := f.Inputs[PuzzleKey{Year: year, Day: day}]
+	if !ok {
+		return "", fmt.Errorf("%w: input of %d day %d", ErrNotFound, year, day)
+	}
+
+	return input, nil
+}
+
+// Puzzle returns the HTML page of the puzzle of the given year and day.
+func (f *Fake) Puzzle(_ context.Context, year, day int) (string, error) {
+	page, ok := f.Pages[PuzzleKey{Year: year, Day: day}]
+	if !ok {
+		return "", fmt.Errorf("%w: puzzle of %d day %d", ErrNotFound, year, day)
+	}
+
+	return page, nil
+}
+
+// Submit records the answer and returns the message adventofcode.com would answer with, comparing it to the
+// right answer of the part: whether it is right, already given, or wrong, with a hint when both are numbers.
+func (f *Fake) Submit(_ context.Context, year, day, part int, answer string) (string, error) {
+	key := AnswerKey{Year: year, Day: day, Part: part}
+
+	right, ok := f.Answers[key]
+	if !ok {
+		return "", fmt.Errorf("%w: answer of %d day %d part %d", ErrNotFound, year, day, part)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.submitted = append(f.submitted, Submitted{AnswerKey: key, Answer: answer})
+
+	if f.solved[key] {
+		return "You don't seem to be solving the right level. Did you already complete it?", nil
+	}
+
+	if answer == right {
+		if f.solved == nil {
+			f.solved = make(map[AnswerKey]bool)
+		}
+
+		f.solved[key] = true
+
+		return "That's the right answer! You are one gold star closer to saving your vacation.", nil
+	}
+
+	return fmt.Sprintf("That's not the right answer%s. Please wait one minute before trying again.", hint(answer, right)), nil
+}
+
+// hint returns the hint of adventofcode.com about a wrong numeric answer, e.g. "; your answer is too high".
+func hint(answer, right string) string {
+	x, okX := new(big.Int).SetString(answer, 10)
+	y, okY := new(big.Int).SetString(right, 10)
+
+	switch {
+	case !okX || !okY:
+		return ""
+	case x.Cmp(y) > 0:
+		return "; your answer is too high"
+	default:
+		return "; your answer is too low"
+	}
+}
+
+// Submissions returns the answers submitted so far, in order.
+func (f *Fake) Submissions() []Submitted {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Submitted(nil), f.submitted...)
+}
+
+// Leaderboard returns the private leaderboard of the given ID. The year is ignored.
+func (f *Fake) Leaderboard(_ context.Context, _, id int) (Leaderboard, error) {
+	leaderboard, ok := f.Leaderboards[id]
+	if !ok {
+		return Leaderboard{}, fmt.Errorf("%w: leaderboard %d", ErrNotFound, id)
+	}
+
+	return leaderboard, nil
+}
+
+// Progress returns the progress of the account on the event of the given year.
+func (f *Fake) Progress(_ context.Context, year int) (Progress, error) {
+	progress, ok := f.Progresses[year]
+	if !ok {
+		return Progress{}, fmt.Errorf("%w: progress of %d", ErrNotFound, year)
+	}
+
+	progress.Days = maps.Clone(progress.Days)
+
+	return progress, nil
+}
+
+// Stars returns the number of stars earned on each event of Progresses, by year.
+func (f *Fake) Stars(_ context.Context) (map[int]int, error) {
+	stars := make(map[int]int, len(f.Progresses))
+	for year, progress := range f.Progresses {
+		stars[year] = progress.Stars()
+	}
+
+	return stars, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+func TestFakeSubmit(t *testing.T) {
+	fake := &aocapi.Fake{Answers: map[aocapi.AnswerKey]string{{Year: 2024, Day: 7, Part: 1}: "3749"}}
+
+	tests := []struct {
+		answer   string
+		expected string
+	}{
+		{answer: "4000", expected: "your answer is too high"},
+		{answer: "3000", expected: "your answer is too low"},
+		{answer: "abc", expected: "That's not the right answer."},
+		{answer: "3749", expected: "That's the right answer!"},
+		{answer: "3749", expected: "Did you already complete it?"},
+	}
+
+	for _, tt := range tests {
+		message, err := fake.Submit(context.Background(), 2024, 7, 1, tt.answer)
+		if err != nil || !strings.Contains(message, tt.expected) {
+			t.Errorf("Submit(%q): expected a message with %q, but got %q (err: %v)", tt.answer, tt.expected, message, err)
+		}
+	}
+
+	if submissions := fake.Submissions(); len(submissions) != len(tests) || submissions[0].Answer != "4000" || submissions[0].Part != 1 {
+		t.Errorf("Expected the submissions to be recorded in order, but got %+v", submissions)
+	}
+
+	if _, err := fake.Submit(context.Background(), 2024, 7, 2, "1"); !errors.Is(err, aocapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for a part without answer, but got: %v", err)
+	}
+}
+
+func TestFakeProgress(t *testing.T) {
+	var client aocapi.Client = &aocapi.Fake{
+		Progresses: map[int]aocapi.Progress{2024: {Year: 2024, Days: map[int]int{1: 2, 2: 1}}},
+	}
+
+	if stars, err := client.Stars(context.Background()); err != nil || stars[2024] != 3 {
+		t.Errorf("Expected 3 stars in 2024, but got %v (err: %v)", stars, err)
+	}
+
+	if _, err := client.Progress(context.Background(), 2023); !errors.Is(err, aocapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, but got: %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// LeaderboardTTL is how long a leaderboard is cached: Advent of Code asks not to poll the private leaderboard
+// endpoint more than once every 15 minutes.
+const LeaderboardTTL = 15 * time.Minute
+
+// Leaderboard is a private leaderboard of an event, as returned by its JSON endpoint.
+type Leaderboard struct {
+	OwnerID int    `json:"owner_id"`
+	Event   string `json:"event"`
+
+	// Day1 is when the puzzle of the first day of the event unlocked.
+	Day1 Timestamp `json:"day1_ts"`
+
+	// Members are the members of the leaderboard, by ID.
+	Members map[int]Member `json:"members"`
+}
+
+// Member is a member of a private leaderboard.
+type Member struct {
+	ID int `json:"id"`
+
+	// Name is the name of the member, empty for anonymous users.
+	Name        string    `json:"name"`
+	Stars       int       `json:"stars"`
+	LocalScore  int       `json:"local_score"`
+	GlobalScore int       `json:"global_score"`
+	LastStar    Timestamp `json:"last_star_ts"`
+
+	// Days holds the stars earned by the member, by day and part.
+	Days map[int]map[int]Star `json:"completion_day_level"`
+}
+
+// Star is a star earned by a member of a private leaderboard.
+type Star struct {
+	// EarnedAt is when the star was earned.
+	EarnedAt Timestamp `json:"get_star_ts"`
+
+	// Index orders the stars earned by all users, so ties at the same second can be broken.
+	Index int64 `json:"star_index"`
+}
+
+// Timestamp is a time encoded by adventofcode.com as a number of seconds since the Unix epoch.
+// Zero encodes the zero time.
+type Timestamp struct {
+	time.Time
+}
+
+// UnmarshalJSON decodes the Unix seconds of data.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var seconds int64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+
+	t.Time = time.Time{}
+	if seconds != 0 {
+		t.Time = time.Unix(seconds, 0).UTC()
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the time as Unix seconds.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("0"), nil
+	}
+
+	return []byte(strconv.FormatInt(t.Unix(), 10)), nil
+}
+
+// Leaderboard returns the private leaderboard of the given event year and ID, the number in its URL, such as
+// the ID of its owner. Leaderboards are cached for LeaderboardTTL, so they can be requested as often as
+// needed. A session that cannot view the leaderboard fails with ErrInvalidResponse.
+//
+// Example:
+//
+//	leaderboard, err := client.Leaderboard(ctx, 2024, 123456)
+func (c *HTTPClient) Leaderboard(ctx context.Context, year, id int) (Leaderboard, error) {
+	var leaderboard Leaderboard
+
+	decode := func(body []byte) error {
+		leaderboard = Leaderboard{}
+		if err := json.Unmarshal(body, &leaderboard); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+		}
+
+		return nil
+	}
+
+	body, err := c.getCached(ctx,
+		fmt.Sprintf("/%d/leaderboard/private/view/%d.json", year, id),
+		fmt.Sprintf("%d/leaderboard-%d.json", year, id),
+		LeaderboardTTL, decode)
+	if err != nil {
+		return Leaderboard{}, err
+	}
+
+	return leaderboard, decode(body)
+}
+
+// Ranking returns the members of the leaderboard ordered by local score, highest first. Ties are ordered by
+// stars, then by who earned their last star first, then by ID.
+func (l Leaderboard) Ranking() []Member {
+	members := make([]Member, 0, len(l.Members))
+	for _, member := range l.Members {
+		members = append(members, member)
+	}
+
+	slices.SortFunc(members, func(a, b Member) int {
+		return cmp.Or(
+			cmp.Compare(b.LocalScore, a.LocalScore),
+			cmp.Compare(b.Stars, a.Stars),
+			a.LastStar.Compare(b.LastStar.Time),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return members
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+const leaderboardJSON = `{"owner_id":1,"event":"2024","day1_ts":1733029200,"members":{
+"1":{"id":1,"name":"alice","stars":3,"local_score":10,"global_score":0,"last_star_ts":1733120000,
+"completion_day_level":{"1":{"1":{"get_star_ts":1733030000,"star_index":5},"2":{"get_star_ts":1733031000,"star_index":9}},
+"2":{"1":{"get_star_ts":1733120000,"star_index":20}}}},
+"2":{"id":2,"name":null,"stars":3,"local_score":10,"global_score":0,"last_star_ts":1733110000,"completion_day_level":{}},
+"3":{"id":3,"name":"carol","stars":1,"local_score":4,"global_score":0,"last_star_ts":0,"completion_day_level":{}}}}`
+
+func newLeaderboardServer(t *testing.T, requests *int, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if r.URL.Path != "/2024/leaderboard/private/view/1.json" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestLeaderboard(t *testing.T) {
+	requests := 0
+	server := newLeaderboardServer(t, &requests, leaderboardJSON)
+	client := aocapi.NewClient("secret")
+	client.BaseURL = server.URL
+
+	for range 2 {
+		leaderboard, err := client.Leaderboard(context.Background(), 2024, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+
+		alice := leaderboard.Members[1]
+		if alice.Name != "alice" || alice.Stars != 3 || alice.LocalScore != 10 {
+			t.Errorf("Expected alice with 3 stars and 10 points, but got %+v", alice)
+		}
+
+		star := alice.Days[1][2]
+		if !star.EarnedAt.Equal(time.Unix(1733031000, 0)) || star.Index != 9 {
+			t.Errorf("Expected the second star of day 1 earned at 1733031000, but got %+v", star)
+		}
+
+		if !leaderboard.Members[3].LastStar.IsZero() {
+			t.Errorf("Expected no last star for carol, but got %v", leaderboard.Members[3].LastStar)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected the leaderboard to be requested once, but got %d requests", requests)
+	}
+}
+
+func TestLeaderboardCacheDir(t *testing.T) {
+	requests := 0
+	server := newLeaderboardServer(t, &requests, leaderboardJSON)
+	dir := t.TempDir()
+
+	// Each client stands for another process, sharing the cache directory.
+	for range 2 {
+		client := &aocapi.HTTPClient{BaseURL: server.URL, Session: "secret", CacheDir: dir}
+
+		if _, err := client.Leaderboard(context.Background(), 2024, 1); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected the leaderboard to be requested once, but got %d requests", requests)
+	}
+}
+
+func TestLeaderboardErrors(t *testing.T) {
+	requests := 0
+	server := newLeaderboardServer(t, &requests, "<html>Log in</html>")
+	client := aocapi.NewClient("secret")
+	client.BaseURL = server.URL
+
+	// An invalid response is not cached.
+	for range 2 {
+		if _, err := client.Leaderboard(context.Background(), 2024, 1); !errors.Is(err, aocapi.ErrInvalidResponse) {
+			t.Errorf("Expected ErrInvalidResponse, but got: %v", err)
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, but got %d", requests)
+	}
+
+	if _, err := client.Leaderboard(context.Background(), 2024, 2); !errors.Is(err, aocapi.ErrRequestFailed) {
+		t.Errorf("Expected ErrRequestFailed, but got: %v", err)
+	}
+}
+
+func TestSessionExpired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/2024/leaderboard/private", http.StatusFound)
+	}))
+	t.Cleanup(server.Close)
+
+	client := &aocapi.HTTPClient{BaseURL: server.URL, Session: "expired"}
+
+	if _, err := client.Leaderboard(context.Background(), 2024, 1); !errors.Is(err, aocapi.ErrSessionExpired) {
+		t.Errorf("Expected ErrSessionExpired, but got: %v", err)
+	}
+}
+
+func TestRanking(t *testing.T) {
+	requests := 0
+	server := newLeaderboardServer(t, &requests, leaderboardJSON)
+	client := aocapi.NewClient("secret")
+	client.BaseURL = server.URL
+
+	leaderboard, err := client.Leaderboard(context.Background(), 2024, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	ranking := leaderboard.Ranking()
+
+	ids := make([]int, len(ranking))
+	for i, member := range ranking {
+		ids[i] = member.ID
+	}
+
+	// Alice and the anonymous user are tied, but the anonymous user earned their last star first.
+	if len(ids) != 3 || ids[0] != 2 || ids[1] != 1 || ids[2] != 3 {
+		t.Errorf("Expected the ranking [2 1 3], but got %v", ids)
+	}
+}
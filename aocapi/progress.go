@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var (
+	// calendarDay matches the label of a day of the calendar of an event, e.g. aria-label="Day 7, two stars".
+	calendarDay = regexp.MustCompile(`aria-label="Day (\d+)(?:, (one star|two stars))?"`)
+
+	// eventStars matches an event and its stars on the events page, e.g. <a href="/2024">[2024]</a> <span class="star-count">38*</span>.
+	eventStars = regexp.MustCompile(`<a href="/(\d{4})">\[\d{4}\]</a>\s*(?:<span class="star-count">(\d+)\*</span>)?`)
+)
+
+// Progress is the number of stars earned by the account on each day of an event.
+type Progress struct {
+	Year int
+
+	// Days holds the stars earned on each unlocked day, from 0 to 2. Locked days are missing.
+	Days map[int]int
+}
+
+// Stars returns the number of stars earned on the event.
+func (p Progress) Stars() int {
+	stars := 0
+	for _, earned := range p.Days {
+		stars += earned
+	}
+
+	return stars
+}
+
+// Complete reports whether both stars of the given day have been earned.
+func (p Progress) Complete(day int) bool {
+	return p.Days[day] == 2
+}
+
+// Progress returns the stars earned by the account on each day of the event of the given year, scraped from
+// the calendar of the event. It is not cached, so it reflects the answers just submitted.
+//
+// Example:
+//
+//	progress, err := client.Progress(ctx, 2024)
+//	if err == nil && progress.Complete(7) {
+//	    fmt.Println("you already have both stars for this day")
+//	}
+func (c *HTTPClient) Progress(ctx context.Context, year int) (Progress, error) {
+	page, err := c.get(ctx, fmt.Sprintf("/%d", year))
+	if err != nil {
+		return Progress{}, err
+	}
+
+	matches := calendarDay.FindAllSubmatch(page, -1)
+	if matches == nil {
+		return Progress{}, fmt.Errorf("%w: no calendar in the page of %d", ErrInvalidResponse, year)
+	}
+
+	progress := Progress{Year: year, Days: make(map[int]int, len(matches))}
+
+	for _, match := range matches {
+		day, err := strconv.Atoi(string(match[1]))
+		if err != nil {
+			return Progress{}, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+		}
+
+		switch string(match[2]) {
+		case "one star":
+			progress.Days[day] = 1
+		case "two stars":
+			progress.Days[day] = 2
+		default:
+			progress.Days[day] = 0
+		}
+	}
+
+	return progress, nil
+}
+
+// Stars returns the number of stars earned by the account on each event, by year, scraped from the events page.
+// Events without stars are included with zero stars.
+//
+// Example:
+//
+//	stars, err := client.Stars(ctx)
+//	fmt.Printf("%d stars in 2024", stars[2024])
+func (c *HTTPClient) Stars(ctx context.Context) (map[int]int, error) {
+	page, err := c.get(ctx, "/events")
+	if err != nil {
+		return nil, err
+	}
+
+	matches := eventStars.FindAllSubmatch(page, -1)
+	if matches == nil {
+		return nil, fmt.Errorf("%w: no event in the events page", ErrInvalidResponse)
+	}
+
+	stars := make(map[int]int, len(matches))
+
+	for _, match := range matches {
+		year, err := strconv.Atoi(string(match[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+		}
+
+		stars[year] = 0
+
+		if len(match[2]) > 0 {
+			if stars[year], err = strconv.Atoi(string(match[2])); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+			}
+		}
+	}
+
+	return stars, nil
+}
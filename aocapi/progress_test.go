@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+const calendarPage = `<pre class="calendar">
+<a aria-label="Day 3" href="/2024/day/3" class="calendar-day3"><span class="calendar-day"> 3</span></a>
+<a aria-label="Day 2, one star" href="/2024/day/2" class="calendar-day2 calendar-complete"><span class="calendar-day"> 2</span></a>
+<a aria-label="Day 1, two stars" href="/2024/day/1" class="calendar-day1 calendar-verycomplete"><span class="calendar-day"> 1</span></a>
+</pre>`
+
+const eventsPage = `<div class="eventlist-event"><a href="/2024">[2024]</a> <span class="star-count">38*</span></div>
+<div class="eventlist-event"><a href="/2023">[2023]</a> <span class="star-count">50*</span></div>
+<div class="eventlist-event"><a href="/2015">[2015]</a></div>`
+
+func newPageServer(t *testing.T, pages map[string]string) *aocapi.HTTPClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(page))
+	}))
+	t.Cleanup(server.Close)
+
+	return &aocapi.HTTPClient{BaseURL: server.URL, Session: "secret"}
+}
+
+func TestProgress(t *testing.T) {
+	client := newPageServer(t, map[string]string{"/2024": calendarPage, "/2023": "<html>Log in</html>"})
+
+	progress, err := client.Progress(context.Background(), 2024)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if progress.Days[1] != 2 || progress.Days[2] != 1 || progress.Days[3] != 0 || len(progress.Days) != 3 {
+		t.Errorf("Expected 2, 1 and 0 stars on days 1 to 3, but got %v", progress.Days)
+	}
+
+	if progress.Stars() != 3 || !progress.Complete(1) || progress.Complete(2) {
+		t.Errorf("Expected 3 stars with day 1 complete, but got %+v", progress)
+	}
+
+	if _, err := client.Progress(context.Background(), 2023); !errors.Is(err, aocapi.ErrInvalidResponse) {
+		t.Errorf("Expected ErrInvalidResponse, but got: %v", err)
+	}
+}
+
+func TestStars(t *testing.T) {
+	client := newPageServer(t, map[string]string{"/events": eventsPage})
+
+	stars, err := client.Stars(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if len(stars) != 3 || stars[2024] != 38 || stars[2023] != 50 || stars[2015] != 0 {
+		t.Errorf("Expected 38, 50 and 0 stars in 2024, 2023 and 2015, but got %v", stars)
+	}
+}
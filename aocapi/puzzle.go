@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hvpaiva/goaoc/internal/htmltext"
+)
+
+// UnlockTime returns when the puzzle of the given year and day unlocks: at midnight EST (UTC-5) in December.
+func UnlockTime(year, day int) time.Time {
+	return time.Date(year, time.December, day, 5, 0, 0, 0, time.UTC)
+}
+
+// Input returns the input of the puzzle of the given year and day, as downloaded, with its final line break.
+func (c *HTTPClient) Input(ctx context.Context, year, day int) (string, error) {
+	body, err := c.send(ctx, request{method: http.MethodGet, path: fmt.Sprintf("/%d/day/%d/input", year, day), unlock: UnlockTime(year, day)})
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+// Puzzle returns the HTML page of the puzzle of the given year and day. The description of part 2 is included
+// once part 1 is solved by the account.
+func (c *HTTPClient) Puzzle(ctx context.Context, year, day int) (string, error) {
+	page := request{method: http.MethodGet, path: fmt.Sprintf("/%d/day/%d", year, day), unlock: UnlockTime(year, day), public: true}
+
+	body, err := c.send(ctx, page)
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+// Submit submits the answer of the given part of the puzzle of the given year and day, and returns the text of
+// the response, without markup. The request is sent once: a failed submission is not retried.
+func (c *HTTPClient) Submit(ctx context.Context, year, day, part int, answer string) (string, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+
+	body, err := c.post(ctx, fmt.Sprintf("/%d/day/%d/answer", year, day), form)
+	if err != nil {
+		return "", err
+	}
+
+	return Message(string(body)), nil
+}
+
+// Message returns the text of the article of a page of adventofcode.com, such as the response to an answer,
+// without markup and with its white space collapsed. A page without article is returned whole, the same way.
+func Message(page string) string {
+	return htmltext.Message(page)
+}
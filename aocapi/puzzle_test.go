@@ -0,0 +1,104 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+func TestInput(t *testing.T) {
+	client := newPageServer(t, map[string]string{"/2024/day/7/input": "190: 10 19\n"})
+
+	if input, err := client.Input(context.Background(), 2024, 7); err != nil || input != "190: 10 19\n" {
+		t.Errorf("Expected the input, but got %q (err: %v)", input, err)
+	}
+
+	if _, err := client.Input(context.Background(), 2024, 8); !errors.Is(err, aocapi.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, but got: %v", err)
+	}
+}
+
+func TestPuzzleLoggedOut(t *testing.T) {
+	page := "<main><article><h2>--- Day 7: Bridge Repair ---</h2></article>" +
+		"<p>To play, please identify yourself via one of these services:</p></main>"
+	client := newPageServer(t, map[string]string{"/2024/day/7": page, "/2024/day/7/input": page})
+
+	if puzzle, err := client.Puzzle(context.Background(), 2024, 7); err != nil || puzzle != page {
+		t.Errorf("Expected the public puzzle page, but got %q (err: %v)", puzzle, err)
+	}
+
+	if _, err := client.Input(context.Background(), 2024, 7); !errors.Is(err, aocapi.ErrSessionExpired) {
+		t.Errorf("Expected ErrSessionExpired for the input, but got: %v", err)
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.UserAgent(), "goaoc/") || !strings.HasSuffix(r.UserAgent(), "; me@example.com)") {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/2024/day/7/answer" ||
+			r.PostFormValue("level") != "1" || r.PostFormValue("answer") != "3749" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`<main><article><p>That's the  right answer!
+You are <em>one gold star</em> closer.</p></article></main>`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := &aocapi.HTTPClient{BaseURL: server.URL, Session: "secret", Contact: "me@example.com"}
+
+	message, err := client.Submit(context.Background(), 2024, 7, 1, "3749")
+	if expected := "That's the right answer! You are one gold star closer."; err != nil || message != expected {
+		t.Errorf("Expected the message %q, but got %q (err: %v)", expected, message, err)
+	}
+
+	if _, err := client.Submit(context.Background(), 2024, 7, 2, "3749"); !errors.Is(err, aocapi.ErrRequestFailed) {
+		t.Errorf("Expected ErrRequestFailed, but got: %v", err)
+	}
+}
+
+func TestSessionFromEnvironment(t *testing.T) {
+	t.Setenv("AOC_SESSION", "shared")
+	t.Setenv("GOAOC_SESSION", "other")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(cookie.Value))
+	}))
+	t.Cleanup(server.Close)
+
+	client := aocapi.NewClient("")
+	client.BaseURL = server.URL
+
+	if input, err := client.Input(context.Background(), 2024, 7); err != nil || input != "shared" {
+		t.Errorf("Expected the session of AOC_SESSION, but got %q (err: %v)", input, err)
+	}
+
+	client.Session = "explicit"
+
+	if input, err := client.Input(context.Background(), 2024, 7); err != nil || input != "explicit" {
+		t.Errorf("Expected the session of the client, but got %q (err: %v)", input, err)
+	}
+}
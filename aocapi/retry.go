@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+)
+
+// maxBackoff bounds the delay between two attempts of a request.
+const maxBackoff = time.Minute
+
+// RetryPolicy sets how failed requests are retried, e.g. to survive the server hiccups of December 1st. Only
+// transient failures are retried: a StatusError of a 5xx response, and timeouts. The zero value never retries.
+type RetryPolicy struct {
+	// Retries is the maximum number of retries of a request.
+	Retries int
+
+	// Backoff is the delay before the first retry. The delay before the n-th retry grows exponentially from it,
+	// as Backoff * 2^(n-1), up to a minute, with a random jitter of up to half of it so concurrent clients do not
+	// retry in lockstep. A Backoff that is not positive retries immediately.
+	Backoff time.Duration
+}
+
+// Do calls attempt until it succeeds, fails with an error that is not transient, or the retries are exhausted,
+// and returns the error of the last attempt, or the cause of ctx when it is done while waiting to retry.
+//
+// Example:
+//
+//	var body string
+//	err := aocapi.RetryPolicy{Retries: 3, Backoff: time.Second}.Do(ctx, func() (err error) {
+//	    body, err = download(ctx)
+//	    return err
+//	})
+func (p RetryPolicy) Do(ctx context.Context, attempt func() error) error {
+	for n := 0; ; n++ {
+		err := attempt()
+		if err == nil || n >= p.Retries || !isTransient(err) || ctx.Err() != nil {
+			return err
+		}
+
+		if err := sleep(ctx, p.delay(n)); err != nil {
+			return err
+		}
+	}
+}
+
+// delay returns the delay before the retry following the n-th failed attempt, starting at 0.
+func (p RetryPolicy) delay(n int) time.Duration {
+	if p.Backoff <= 0 {
+		return 0
+	}
+
+	// The backoff is only shifted when it stays under maxBackoff, so the shift cannot overflow.
+	delay := maxBackoff
+	if n < 63 && p.Backoff <= maxBackoff>>n {
+		delay = p.Backoff << n
+	}
+
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// isTransient reports whether err is a failure that may succeed when retried: a 5xx response or a timeout.
+func isTransient(err error) bool {
+	var status StatusError
+	if errors.As(err, &status) {
+		return status.Code >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// StatusError is the error of a response with an unexpected HTTP status, returned wrapped in ErrRequestFailed.
+type StatusError struct {
+	// Code is the status code of the response, such as 503.
+	Code int
+
+	// Status is the status of the response, such as "503 Service Unavailable".
+	Status string
+
+	// Body is the beginning of the body of the response, which usually explains the failure.
+	Body string
+}
+
+// Error implements the error interface for StatusError, returning the status, such as "503 Service Unavailable".
+func (e StatusError) Error() string {
+	return e.Status
+}
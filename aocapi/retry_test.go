@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+func TestRetry(t *testing.T) {
+	testCases := []struct {
+		name             string
+		statuses         []int
+		submit           bool
+		expectedRequests int
+		expectErr        bool
+	}{
+		{"ServerErrorsRetried", []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK}, false, 3, false},
+		{"ClientErrorNotRetried", []int{http.StatusBadRequest, http.StatusOK}, false, 1, true},
+		{"AnswerNotRetried", []int{http.StatusServiceUnavailable, http.StatusOK}, true, 1, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.statuses[requests])
+				requests++
+			}))
+			t.Cleanup(server.Close)
+
+			client := &aocapi.HTTPClient{BaseURL: server.URL, Session: "secret", Retry: aocapi.RetryPolicy{Retries: 3}}
+
+			var err error
+			if tc.submit {
+				_, err = client.Submit(context.Background(), 2024, 7, 1, "42")
+			} else {
+				_, err = client.Input(context.Background(), 2024, 7)
+			}
+
+			if (err != nil) != tc.expectErr || requests != tc.expectedRequests {
+				t.Errorf("Expected %d requests (error: %v), but got %d (err: %v)", tc.expectedRequests, tc.expectErr, requests, err)
+			}
+
+			var status aocapi.StatusError
+			if err != nil && (!errors.Is(err, aocapi.ErrRequestFailed) || !errors.As(err, &status) || status.Code != tc.statuses[0]) {
+				t.Errorf("Expected a StatusError %d wrapped in ErrRequestFailed, but got: %v", tc.statuses[0], err)
+			}
+		})
+	}
+}
+
+func TestNotFoundNotRequestedAgain(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	for range 2 {
+		client := &aocapi.HTTPClient{BaseURL: server.URL, Session: "secret"}
+		if _, err := client.Input(context.Background(), 2024, 25); !errors.Is(err, aocapi.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, but got: %v", err)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected the input to be requested once, but got %d requests", requests)
+	}
+}
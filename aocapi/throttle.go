@@ -0,0 +1,60 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package aocapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RequestInterval is the minimum interval between two requests to adventofcode.com, following the automation
+// guidelines of Advent of Code.
+const RequestInterval = 5 * time.Second
+
+// requests spaces out the requests to adventofcode.com made by every HTTPClient of the process.
+var requests = &throttle{interval: RequestInterval}
+
+// throttle enforces a minimum interval between the operations that wait on it.
+type throttle struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the interval since the previous operation has passed, or ctx is done.
+// Concurrent callers are queued, each reserving its own slot.
+func (t *throttle) wait(ctx context.Context) error {
+	t.mu.Lock()
+
+	now := time.Now()
+	slot := t.next
+
+	if slot.Before(now) {
+		slot = now
+	}
+
+	t.next = slot.Add(t.interval)
+	t.mu.Unlock()
+
+	return sleep(ctx, slot.Sub(now))
+}
+
+// sleep blocks for the delay, or until ctx is done, returning its cause.
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hvpaiva/goaoc/internal/atomicfile"
+)
+
+var (
+	// puzzleTitle matches the title of a puzzle page, e.g. "<h2>--- Day 7: Bridge Repair ---</h2>".
+	puzzleTitle = regexp.MustCompile(`<h2[^>]*>--- Day \d+: (.*?) ---</h2>`)
+
+	// puzzleAnswer matches the answer of a solved part of a puzzle page, which earned a star.
+	puzzleAnswer = regexp.MustCompile(`Your puzzle answer was`)
+)
+
+// Puzzle describes a puzzle of adventofcode.com, as seen by the account of the session, see FetchPuzzle.
+type Puzzle struct {
+	Year  int    `json:"year"`
+	Day   int    `json:"day"`
+	Title string `json:"title"`
+
+	// Stars is the number of stars earned on the puzzle by the account of the session, from 0 to 2.
+	Stars int `json:"stars"`
+}
+
+// String returns the day, title and stars of the puzzle, e.g. "Day 7: Bridge Repair ★☆".
+func (p Puzzle) String() string {
+	stars := min(max(p.Stars, 0), 2)
+
+	return fmt.Sprintf("Day %d: %s %s%s", p.Day, p.Title, strings.Repeat("★", stars), strings.Repeat("☆", 2-stars))
+}
+
+// FetchPuzzle downloads the page of the puzzle of the given year and day from adventofcode.com, authenticated
+// by the session cookie resolved by ResolveSession, and returns its title and the stars earned on it.
+// The request options, such as WithHTTPClient, apply to the download, like for Submit.
+//
+// Example:
+//
+//	puzzle, err := FetchPuzzle(ctx, 2024, 7)
+//	fmt.Println(puzzle) // Day 7: Bridge Repair ★☆
+func FetchPuzzle(ctx context.Context, year, day int, options ...RunOption) (Puzzle, error) {
+	var opts runOptions
+	if err := applyOptions(&opts, options...); err != nil {
+		return Puzzle{}, err
+	}
+
+	return opts.aocProvider().puzzle(ctx, year, day)
+}
+
+// puzzle downloads the page of the puzzle of the given year and day and returns its description.
+func (p aocProvider) puzzle(ctx context.Context, year, day int) (Puzzle, error) {
+	page, err := p.page(ctx, year, day)
+	if err != nil {
+		return Puzzle{}, err
+	}
+
+	return parsePuzzle(year, day, page), nil
+}
+
+// parsePuzzle returns the description of the puzzle of the given year and day from its page.
+func parsePuzzle(year, day int, page string) Puzzle {
+	puzzle := Puzzle{Year: year, Day: day, Stars: len(puzzleAnswer.FindAllStringIndex(page, 2))}
+
+	if match := puzzleTitle.FindStringSubmatch(page); match != nil {
+		puzzle.Title = html.UnescapeString(match[1])
+	}
+
+	return puzzle
+}
+
+// writeBanner writes the title and stars of the puzzle, noting when both are already earned, to the stdout of
+// the console manager before a run, when the year and day are known. The puzzle page is only downloaded, when a
+// session or a Client is configured, if it is not stored in the input cache yet, see storePage, and the parts
+// solved since it was stored are counted from the recorded submissions. It is skipped offline and for other
+// OutputWriters, such as the managers of tests. The banner is informative, so failing to fetch the puzzle is
+// not an error.
+func (o *runOptions) writeBanner(ctx context.Context) {
+	if !o.consoleOutput() || o.isOffline() {
+		return
+	}
+
+	year, day, err := o.puzzle(0, 0)
+	if err != nil {
+		return
+	}
+
+	cache, err := o.inputCache()
+	if err != nil {
+		return
+	}
+
+	puzzle, ok, err := cache.loadPuzzle(year, day)
+	if err != nil {
+		return
+	}
+
+	if !ok {
+		if _, _, err := resolveSession(o.session, o.profile); err != nil && o.client == nil {
+			return
+		}
+
+		page, err := o.aocProvider().page(ctx, year, day)
+		if err != nil {
+			return
+		}
+
+		// The page is stored so the next runs do not download it again, but the banner does not depend on it.
+		_, _ = cache.storePage(year, day, page)
+
+		puzzle = parsePuzzle(year, day, page)
+	}
+
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if puzzle.Stars == 2 {
+		_, _ = fmt.Fprintf(o.stdout(), "%s (you already have both stars for this day)\n", puzzle)
+
+		return
+	}
+
+	_, _ = fmt.Fprintln(o.stdout(), puzzle)
+}
+
+// consoleOutput reports whether the answers are written by the console manager.
+func (o *runOptions) consoleOutput() bool {
+	switch o.writer.(type) {
+	case DefaultConsoleManager, *DefaultConsoleManager:
+		return true
+	default:
+		return false
+	}
+}
+
+// puzzlePath returns the path of the title and stars of the puzzle of the given year and day, stored with its
+// examples, e.g. <Dir>/2024/day07/puzzle.json.
+func (c InputCache) puzzlePath(year, day int) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), fmt.Sprintf("day%02d", day), "puzzle.json")
+}
+
+// storePuzzle stores the title and stars of the puzzle page of the given year and day, for the banner.
+func (c InputCache) storePuzzle(year, day int, page string) error {
+	content, err := json.Marshal(parsePuzzle(year, day, page))
+	if err != nil {
+		return err
+	}
+
+	path := c.puzzlePath(year, day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return atomicfile.Write(path, content, 0o600)
+}
+
+// loadPuzzle returns the puzzle of the given year and day stored by storePuzzle, earning a star for each part
+// with a right answer recorded since, and reports false if it is not stored.
+func (c InputCache) loadPuzzle(year, day int) (Puzzle, bool, error) {
+	content, err := os.ReadFile(c.puzzlePath(year, day))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Puzzle{}, false, nil
+	}
+
+	if err != nil {
+		return Puzzle{}, false, err
+	}
+
+	var puzzle Puzzle
+	if err := json.Unmarshal(content, &puzzle); err != nil {
+		return Puzzle{}, false, err
+	}
+
+	log, err := c.loadSubmissions(year, day)
+	if err != nil {
+		return Puzzle{}, false, err
+	}
+
+	// The stars of the page are the ones of its first parts, so a later part with a right answer earned another.
+	stars := puzzle.Stars
+
+	for part, ledger := range log.Parts {
+		if ledger.Answer != "" && int(part) > stars {
+			puzzle.Stars++
+		}
+	}
+
+	return puzzle, true, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParsePuzzle(t *testing.T) {
+	puzzle := parsePuzzle(2024, 7, puzzlePage)
+
+	if expected := (Puzzle{Year: 2024, Day: 7, Title: "Bridge & Repair", Stars: 1}); puzzle != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, puzzle)
+	}
+
+	if expected := "Day 7: Bridge & Repair ★☆"; puzzle.String() != expected {
+		t.Errorf("Expected %q, but got %q", expected, puzzle.String())
+	}
+}
+
+func TestWriteBannerCompletePuzzle(t *testing.T) {
+	stdout := new(bytes.Buffer)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(puzzlePage + "<p>Your puzzle answer was <code>11387</code>.</p>"))
+	}))
+	t.Cleanup(server.Close)
+
+	opts := runOptions{
+		writer: DefaultConsoleManager{Env: mockEnv(nil, "", stdout)}, year: 2024, day: 7,
+		baseURL: server.URL, session: "secret", cacheDir: t.TempDir(),
+	}
+	opts.writeBanner(context.Background())
+
+	if expected := "Day 7: Bridge & Repair ★★ (you already have both stars for this day)\n"; stdout.String() != expected {
+		t.Errorf("Expected %q, but got %q", expected, stdout.String())
+	}
+}
+
+func TestWriteBanner(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	downloads := 0
+	server := newInputServer(t, http.StatusOK, &downloads)
+	stdout := new(bytes.Buffer)
+
+	testCases := []struct {
+		name     string
+		opts     runOptions
+		expected string
+	}{
+		{
+			name:     "Console",
+			opts:     runOptions{writer: DefaultConsoleManager{Env: mockEnv(nil, "", stdout)}, year: 2024, day: 7},
+			expected: "Day 7: Bridge & Repair ★☆\n",
+		},
+		{
+			name: "Offline",
+			opts: runOptions{writer: DefaultConsoleManager{Env: mockEnv(nil, "", stdout)}, year: 2024, day: 7, offline: true},
+		},
+		{
+			name: "UnknownPuzzle",
+			opts: runOptions{writer: DefaultConsoleManager{Env: mockEnv(nil, "", stdout)}},
+		},
+		{
+			name: "CustomWriter",
+			opts: runOptions{writer: writerFunc(func(string) error { return nil }), year: 2024, day: 7},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout.Reset()
+
+			tc.opts.baseURL = server.URL
+			tc.opts.cacheDir = t.TempDir()
+			tc.opts.writeBanner(context.Background())
+
+			if stdout.String() != tc.expected {
+				t.Errorf("Expected %q, but got %q", tc.expected, stdout.String())
+			}
+		})
+	}
+}
+
+func TestWriteBannerCached(t *testing.T) {
+	requests := 0
+	stdout := new(bytes.Buffer)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		_, _ = w.Write([]byte(puzzlePage))
+	}))
+	t.Cleanup(server.Close)
+
+	opts := runOptions{
+		writer: DefaultConsoleManager{Env: mockEnv(nil, "", stdout)}, year: 2024, day: 7,
+		baseURL: server.URL, session: "secret", cacheDir: t.TempDir(),
+	}
+
+	for range 2 {
+		opts.writeBanner(context.Background())
+	}
+
+	if expected := "Day 7: Bridge & Repair ★☆\n"; stdout.String() != expected+expected || requests != 1 {
+		t.Errorf("Expected the banner %q twice from a single request, but got %q from %d requests", expected, stdout.String(), requests)
+	}
+
+	cache, err := opts.inputCache()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := cache.recordSubmission(2024, 7, 2, "11387", Submission{Verdict: Correct}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	stdout.Reset()
+	opts.writeBanner(context.Background())
+
+	if expected := "Day 7: Bridge & Repair ★★ (you already have both stars for this day)\n"; stdout.String() != expected || requests != 1 {
+		t.Errorf("Expected the banner %q with the recorded answer, but got %q from %d requests", expected, stdout.String(), requests)
+	}
+}
+
+func TestFetchPuzzleWithHTTPClient(t *testing.T) {
+	var requested []string
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requested = append(requested, req.URL.String())
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(puzzlePage)), Request: req}, nil
+	})
+
+	puzzle, err := FetchPuzzle(context.Background(), 2024, 7, WithSession("secret"), WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil || puzzle.Title != "Bridge & Repair" {
+		t.Fatalf("Expected the puzzle of the transport, but got %+v (err: %v)", puzzle, err)
+	}
+
+	if len(requested) != 1 || requested[0] != "https://adventofcode.com/2024/day/7" {
+		t.Errorf("Expected the request to go through the client, but got %v", requested)
+	}
+
+	if _, err := FetchPuzzle(context.Background(), 2024, 7, WithAutoSubmit(0)); !errors.Is(err, ErrInvalidSubmitMode) {
+		t.Errorf("Expected the error of the option, but got: %v", err)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// namedInput is an input source registered with WithNamedInput.
+type namedInput struct {
+	name   string
+	source func(ctx context.Context) (string, error)
+}
+
+// WithNamedInput creates a RunOption that registers the input of the given input option under name,
+// e.g. to register example1, example2 and real inputs. When inputs are registered, Run and its variants
+// execute the selected part against each of them, like RunBatch, and write a table of the input names,
+// answers and durations instead of a single answer; the input argument of Run is then ignored.
+// A name registered twice keeps the last input. The input option only sets the named input: the input, the
+// input file and the puzzle year and day of the run are kept.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func,
+//	    WithNamedInput("example", WithInputFile("example.txt")),
+//	    WithNamedInput("real", WithInputDownload(2024, 7)))
+func WithNamedInput(name string, input RunOption) RunOption {
+	return func(options *runOptions) error {
+		// The input options set the input, its file, whether it is the sample, and the puzzle of the run, which are
+		// restored once the source of the named input is taken.
+		previous := *options
+		if err := input(options); err != nil {
+			return err
+		}
+
+		// WithSample only switches the run to the sample, so its named input is the sample itself.
+		source := options.input
+		if options.sample && !previous.sample {
+			source = options.loadSample
+		}
+
+		options.namedInputs = append(options.namedInputs, namedInput{name: name, source: source})
+		options.input, options.inputPath, options.sample = previous.input, previous.inputPath, previous.sample
+		options.year, options.day = previous.year, previous.day
+
+		return nil
+	}
+}
+
+// loadNamedInputs reads and normalizes the inputs registered with WithNamedInput.
+func (o runOptions) loadNamedInputs(ctx context.Context) (map[string]string, error) {
+	inputs := make(map[string]string, len(o.namedInputs))
+
+	for _, named := range o.namedInputs {
+		if named.source == nil {
+			return nil, BatchError{Name: named.name, Err: IOReadError{Err: ErrMissingInput}}
+		}
+
+		input, err := o.read(ctx, named.source)
+		if err != nil {
+			return nil, BatchError{Name: named.name, Err: err}
+		}
+
+		inputs[named.name] = input
+	}
+
+	return inputs, nil
+}
+
+// BatchResult holds the outcome of running the selected parts against one named input of a batch.
+// Results is empty when Err is not nil.
+type BatchResult[T comparable] struct {
+	Name    string
+	Results []Result[T]
+	Err     error
+}
+
+// RunBatch executes the selected part, or parts, of partOne and partTwo against each of the named inputs,
+// e.g. the sample, the real input and edge cases, which is useful to regression-check a refactor.
+// The part is resolved once, and the inputs are executed by a pool of GOMAXPROCS workers, or one at a time
+// with WithSequential. Input options are ignored, since every input comes from inputs, but every input is
+// normalized as set with WithInputNormalization.
+//
+// A table with the answer and duration of every input is written via the configured OutputWriter, and the
+// results are returned ordered by input name. Hooks are called one at a time, and validators and after-run
+// hooks apply to every result. Inputs that failed are reported in the table and in the returned error,
+// which joins a BatchError for each of them. Inputs registered with WithNamedInput are run too.
+//
+// Example:
+//
+//	results, err := RunBatch(map[string]string{"sample": sample, "real": input}, part1Func, part2Func)
+func RunBatch[T comparable](inputs map[string]string, partOne, partTwo Challenge[T], options ...RunOption) ([]BatchResult[T], error) {
+	var opts runOptions
+
+	batch, err := runBatch(context.Background(), &opts, inputs, withErrors(partOne, partTwo), options...)
+	if err != nil {
+		for _, handle := range opts.onError {
+			handle(err)
+		}
+	}
+
+	return batch, err
+}
+
+// runBatch configures opts from options and runs the challenges against every input with them.
+func runBatch[T comparable](ctx context.Context, opts *runOptions, inputs map[string]string, challenges []ChallengeE[T], options ...RunOption) ([]BatchResult[T], error) {
+	if err := injectOptions(opts, len(challenges), options...); err != nil {
+		return nil, err
+	}
+
+	named, err := opts.loadNamedInputs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, input := range inputs {
+		named[name] = opts.normalize(input)
+	}
+
+	return executeNamedInputs(ctx, opts, named, challenges)
+}
+
+// runNamedInputs runs the challenges against the inputs registered with WithNamedInput, returning the results
+// of all of them, ordered by input name.
+func runNamedInputs[T comparable](ctx context.Context, opts *runOptions, challenges []ChallengeE[T]) ([]Result[T], error) {
+	inputs, err := opts.loadNamedInputs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := executeNamedInputs(ctx, opts, inputs, challenges)
+
+	var results []Result[T]
+	for _, entry := range batch {
+		results = append(results, entry.Results...)
+	}
+
+	return results, err
+}
+
+// executeNamedInputs runs the challenges against every one of the normalized inputs, writes the table of their
+// results and returns them ordered by input name.
+func executeNamedInputs[T comparable](ctx context.Context, opts *runOptions, normalized map[string]string, challenges []ChallengeE[T]) ([]BatchResult[T], error) {
+	names := make([]string, 0, len(normalized))
+	for name := range normalized {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	batch := make([]BatchResult[T], len(names))
+	for i, name := range names {
+		batch[i].Name = name
+	}
+
+	executeBatch(ctx, normalized, batch, challenges, serializeBeforeRun(*opts))
+
+	var errs []error
+
+	for i := range batch {
+		if batch[i].Err == nil {
+			batch[i].Err = acceptBatchResult(*opts, normalized[batch[i].Name], batch[i].Results)
+		}
+
+		if batch[i].Err != nil {
+			batch[i].Results = nil
+			errs = append(errs, BatchError{Name: batch[i].Name, Err: batch[i].Err})
+		}
+	}
+
+	table, err := formatBatch(*opts, batch)
+	if err != nil {
+		return batch, err
+	}
+
+	if err := opts.writer.Write(table); err != nil {
+		return batch, err
+	}
+
+	return batch, errors.Join(errs...)
+}
+
+// executeBatch executes the selected parts against the input of every batch entry, using a pool of workers.
+func executeBatch[T comparable](ctx context.Context, inputs map[string]string, batch []BatchResult[T], challenges []ChallengeE[T], opts runOptions) {
+	workers := min(runtime.GOMAXPROCS(0), len(batch))
+	if opts.sequential {
+		workers = min(1, len(batch))
+	}
+
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				batch[i].Results, batch[i].Err = executeParts(ctx, inputs[batch[i].Name], challenges, opts)
+			}
+		}()
+	}
+
+	for i := range batch {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+}
+
+// serializeBeforeRun returns a copy of opts whose before-run hooks are never called concurrently,
+// as the workers of a batch notify them from their own goroutines.
+func serializeBeforeRun(opts runOptions) runOptions {
+	if len(opts.beforeRun) == 0 {
+		return opts
+	}
+
+	var mu sync.Mutex
+
+	hooks := opts.beforeRun
+	opts.beforeRun = []func(part Part, input string){func(part Part, input string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, hook := range hooks {
+			hook(part, input)
+		}
+	}}
+
+	return opts
+}
+
+// acceptBatchResult sets the metadata of the results of a batch entry and accepts each of them.
+func acceptBatchResult[T comparable](opts runOptions, input string, results []Result[T]) error {
+	metadata := newMetadata(input, time.Now())
+
+	for i := range results {
+		results[i].Metadata = metadata
+
+		if err := acceptResult(opts, results[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatBatch renders the batch as a table with a row for each result, or for each failed input.
+// Answers are rendered as they are written by Run.
+func formatBatch[T comparable](opts runOptions, batch []BatchResult[T]) (string, error) {
+	var table strings.Builder
+
+	w := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "INPUT\tPART\tANSWER\tDURATION")
+
+	for _, entry := range batch {
+		if entry.Err != nil {
+			_, _ = fmt.Fprintf(w, "%s\t-\terror: %v\t-\n", entry.Name, firstLine(entry.Err.Error()))
+
+			continue
+		}
+
+		for _, result := range entry.Results {
+			answer, err := renderAnswer(opts, result.Answer)
+			if err != nil {
+				return "", err
+			}
+
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", entry.Name, result.Part, firstLine(answer), result.Duration)
+		}
+	}
+
+	_ = w.Flush()
+
+	return strings.TrimSuffix(table.String(), "\n"), nil
+}
+
+// firstLine returns s up to its first line break, keeping multi-line answers and errors to one table row.
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+
+	return line
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestWithNamedInputKeepsInputState(t *testing.T) {
+	dir := t.TempDir()
+	input, example := filepath.Join(dir, "input.txt"), filepath.Join(dir, "example.txt")
+	writeFile(t, input, "input")
+	writeFile(t, example, "example")
+
+	cache := InputCache{Dir: filepath.Join(dir, "cache")}
+	if err := cache.Store(2024, 7, "real"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	writeFile(t, cache.ExamplePath(2023, 1, 1), "sample")
+
+	var opts runOptions
+	if err := applyOptions(&opts, WithInputFile(input), WithYear(2023), WithDay(1), WithCacheDir(cache.Dir), WithOffline(),
+		WithNamedInput("example", WithInputFile(example)),
+		WithNamedInput("real", WithInputDownload(2024, 7)),
+		WithNamedInput("sample", WithSample())); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if opts.inputPath != input || opts.year != 2023 || opts.day != 1 || opts.sample {
+		t.Errorf("Expected the input state of the run to be kept, but got path %q, puzzle %d/%d and sample %t",
+			opts.inputPath, opts.year, opts.day, opts.sample)
+	}
+
+	if len(opts.namedInputs) != 3 || opts.input == nil {
+		t.Fatalf("Expected 3 named inputs and the input of the run, but got %d", len(opts.namedInputs))
+	}
+
+	inputs, err := opts.loadNamedInputs(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for name, expected := range map[string]string{"example": "example", "real": "real", "sample": "sample"} {
+		if inputs[name] != expected {
+			t.Errorf("Expected the named input %q to be %q, but got %q", name, expected, inputs[name])
+		}
+	}
+
+	if content, err := opts.input(context.Background()); err != nil || content != "input" {
+		t.Errorf("Expected the input of the run, but got %q (err: %v)", content, err)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc"
+	"github.com/hvpaiva/goaoc/mock"
+)
+
+func lengthPart(input string) int {
+	return len(input)
+}
+
+func TestRunBatch(t *testing.T) {
+	inputs := map[string]string{"sample": "abc", "real": "abcdef", "edge": ""}
+
+	mok := mock.NewManager("1", nil, nil)
+	batch, err := goaoc.RunBatch(inputs, lengthPart, lengthPart, goaoc.WithManager(&mok))
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []struct {
+		name   string
+		answer int
+	}{{"edge", 0}, {"real", 6}, {"sample", 3}}
+
+	if len(batch) != len(expected) {
+		t.Fatalf("Expected %d results, but got %d", len(expected), len(batch))
+	}
+
+	for i, want := range expected {
+		entry := batch[i]
+		if entry.Name != want.name || len(entry.Results) != 1 || entry.Results[0].Answer != want.answer {
+			t.Errorf("Expected %s with answer %d, but got %+v", want.name, want.answer, entry)
+		}
+	}
+
+	output := mok.GetStdout()
+	for _, row := range []string{"INPUT", "edge    1     0", "real    1     6", "sample  1     3"} {
+		if !strings.Contains(output, row) {
+			t.Errorf("Expected the table to contain '%s', but got:\n%s", row, output)
+		}
+	}
+}
+
+func TestRunBatchWithFailingInput(t *testing.T) {
+	errEmpty := errors.New("empty input")
+	failOnEmpty := func(input string) int {
+		if input == "" {
+			panic(errEmpty)
+		}
+
+		return len(input)
+	}
+
+	mok := mock.NewManager("2", nil, nil)
+	batch, err := goaoc.RunBatch(map[string]string{"empty": "", "real": "abc"}, lengthPart, failOnEmpty,
+		goaoc.WithManager(&mok), goaoc.WithSequential())
+
+	var batchErr goaoc.BatchError
+	if !errors.As(err, &batchErr) || batchErr.Name != "empty" || !errors.Is(err, errEmpty) {
+		t.Fatalf("Expected a BatchError for the empty input, but got: %v", err)
+	}
+
+	if batch[0].Err == nil || batch[1].Err != nil || batch[1].Results[0].Answer != 3 {
+		t.Errorf("Expected only the empty input to fail, but got %+v", batch)
+	}
+
+	if output := mok.GetStdout(); !strings.Contains(output, "empty  -     error: challenge part 2 panicked: empty input") {
+		t.Errorf("Expected the table to report the failure, but got:\n%s", output)
+	}
+}
+
+func TestRunWithNamedInputs(t *testing.T) {
+	mok := mock.NewManager("1", nil, nil)
+	results, err := goaoc.RunResults("ignored", lengthPart, lengthPart,
+		goaoc.WithManager(&mok),
+		goaoc.WithNamedInput("example2", goaoc.WithInputString("ab")),
+		goaoc.WithNamedInput("example1", goaoc.WithInputString("a")),
+		goaoc.WithNamedInput("real", goaoc.WithInputFunc(func() (string, error) { return "abcdef", nil })))
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(results) != 3 || results[0].Answer != 1 || results[1].Answer != 2 || results[2].Answer != 6 {
+		t.Errorf("Expected answers 1, 2 and 6 ordered by input name, but got %+v", results)
+	}
+
+	output := mok.GetStdout()
+	for _, row := range []string{"example1  1     1", "example2  1     2", "real      1     6"} {
+		if !strings.Contains(output, row) {
+			t.Errorf("Expected the table to contain '%s', but got:\n%s", row, output)
+		}
+	}
+}
+
+func TestRunWithNamedInputErrors(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+
+	testCases := []struct {
+		name   string
+		source goaoc.RunOption
+		target error
+	}{
+		{"MissingSource", goaoc.WithPart(1), goaoc.ErrMissingInput},
+		{"FailingSource", goaoc.WithInputFunc(func() (string, error) { return "", errFetch }), errFetch},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager("1", nil, nil)
+			err := goaoc.Solve(lengthPart, lengthPart, goaoc.WithManager(&mok), goaoc.WithNamedInput("real", tc.source))
+
+			var batchErr goaoc.BatchError
+			if !errors.As(err, &batchErr) || batchErr.Name != "real" || !errors.Is(err, tc.target) {
+				t.Errorf("Expected a BatchError for the real input wrapping %v, but got: %v", tc.target, err)
+			}
+		})
+	}
+}
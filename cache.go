@@ -0,0 +1,241 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+	"github.com/hvpaiva/goaoc/internal/atomicfile"
+)
+
+// InputCache stores downloaded puzzle inputs under Dir, keyed by year and day,
+// so repeated runs never download the same input from the AoC servers again.
+type InputCache struct {
+	Dir string
+
+	// Session identifies the account whose inputs are stored, such as a hash of its session cookie.
+	// Inputs stored for another account are stale, see Fresh. When empty, the account is not checked.
+	Session string
+}
+
+// CacheInfo describes how a cached input was downloaded. It is stored alongside the input.
+type CacheInfo struct {
+	DownloadedAt time.Time `json:"downloaded_at"`
+	Session      string    `json:"session,omitempty"`
+
+	// SHA256 is the hex-encoded SHA-256 checksum of the input, validated when it is loaded.
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// DefaultInputCache returns the cache used by WithInputDownload when no directory is set with WithCacheDir.
+// Its directory is the GOAOC_CACHE_DIR environment variable when set, or the goaoc directory in the
+// user cache directory, such as $XDG_CACHE_HOME/goaoc on Linux.
+func DefaultInputCache() (InputCache, error) {
+	if dir := os.Getenv("GOAOC_CACHE_DIR"); dir != "" {
+		return InputCache{Dir: dir}, nil
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return InputCache{}, err
+	}
+
+	return InputCache{Dir: filepath.Join(dir, "goaoc")}, nil
+}
+
+// Path returns the path of the cached input for the given year and day, e.g. <Dir>/2024/day07.txt.
+func (c InputCache) Path(year, day int) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), fmt.Sprintf("day%02d.txt", day))
+}
+
+// Load returns the cached input for the given year and day. It reports false if the input is not cached.
+// An input not matching the checksum stored with it, e.g. because the file was truncated or edited, fails
+// with ErrCorruptedCache.
+func (c InputCache) Load(year, day int) (string, bool, error) {
+	path := c.Path(year, day)
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", false, nil
+	}
+
+	if err != nil {
+		return "", false, err
+	}
+
+	info, ok, err := c.Info(year, day)
+	if err != nil {
+		return "", false, err
+	}
+
+	if ok && info.SHA256 != "" && info.SHA256 != checksum(string(content)) {
+		return "", false, fmt.Errorf("%w: %s", ErrCorruptedCache, path)
+	}
+
+	return string(content), true, nil
+}
+
+// Store saves the input for the given year and day, creating the cache directories as needed.
+// Inputs are personal, so the files are only readable by the current user. The files are replaced
+// atomically, so concurrent runs never read or leave a partially written input.
+func (c InputCache) Store(year, day int, input string) error {
+	path := c.Path(year, day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	if err := atomicfile.Write(path, []byte(input), 0o600); err != nil {
+		return err
+	}
+
+	return c.storeInfo(year, day, CacheInfo{DownloadedAt: time.Now().UTC(), Session: c.Session, SHA256: checksum(input)})
+}
+
+// Info returns how the cached input for the given year and day was downloaded. It reports false
+// if there is no such information, e.g. for inputs cached by older versions of goaoc.
+func (c InputCache) Info(year, day int) (CacheInfo, bool, error) {
+	content, err := os.ReadFile(c.infoPath(year, day))
+	if errors.Is(err, fs.ErrNotExist) {
+		return CacheInfo{}, false, nil
+	}
+
+	if err != nil {
+		return CacheInfo{}, false, err
+	}
+
+	var info CacheInfo
+	if err := json.Unmarshal(content, &info); err != nil {
+		return CacheInfo{}, false, err
+	}
+
+	return info, true, nil
+}
+
+// Fresh reports whether the cached input for the given year and day can be trusted. An input is stale when
+// it was downloaded before the puzzle unlocked, so it is an error page rather than the input, or with the
+// session of another account than Session, as inputs differ by account. Inputs without information are fresh.
+func (c InputCache) Fresh(year, day int) (bool, error) {
+	info, ok, err := c.Info(year, day)
+	if err != nil || !ok {
+		return true, err
+	}
+
+	if info.DownloadedAt.Before(aocapi.UnlockTime(year, day)) {
+		return false, nil
+	}
+
+	return c.Session == "" || info.Session == "" || info.Session == c.Session, nil
+}
+
+// checksum returns the hex-encoded SHA-256 checksum of input.
+func checksum(input string) string {
+	sum := sha256.Sum256([]byte(input))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// storeInfo saves the information of the cached input for the given year and day.
+func (c InputCache) storeInfo(year, day int, info CacheInfo) error {
+	content, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.Write(c.infoPath(year, day), content, 0o600)
+}
+
+// infoPath returns the path of the information of the cached input for the given year and day, e.g. <Dir>/2024/day07.json.
+func (c InputCache) infoPath(year, day int) string {
+	return strings.TrimSuffix(c.Path(year, day), ".txt") + ".json"
+}
+
+// Invalidate removes the cached input for the given year and day, so the next run downloads it again.
+// Invalidating an input that is not cached is not an error.
+func (c InputCache) Invalidate(year, day int) error {
+	for _, path := range []string{c.Path(year, day), c.infoPath(year, day)} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InvalidateCache removes the input for the given year and day from the DefaultInputCache,
+// for the rare case when it must be downloaded again.
+//
+// Example:
+//
+//	if err := InvalidateCache(2024, 7); err != nil {
+//	    log.Fatal(err)
+//	}
+func InvalidateCache(year, day int) error {
+	cache, err := DefaultInputCache()
+	if err != nil {
+		return err
+	}
+
+	return cache.Invalidate(year, day)
+}
+
+// WithCacheDir creates a RunOption that sets the directory of the InputCache used by WithInputDownload,
+// instead of the directory of the DefaultInputCache.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 7), WithCacheDir(".aoc-cache"))
+func WithCacheDir(dir string) RunOption {
+	return func(options *runOptions) error {
+		options.cacheDir = dir
+
+		return nil
+	}
+}
+
+// inputCache returns the InputCache set with WithCacheDir, or the DefaultInputCache, for the account
+// of the session set with WithSession or resolved by ResolveSession.
+func (o *runOptions) inputCache() (InputCache, error) {
+	cache := InputCache{Dir: o.cacheDir}
+
+	if o.cacheDir == "" {
+		var err error
+		if cache, err = DefaultInputCache(); err != nil {
+			return InputCache{}, err
+		}
+	}
+
+	if o.profile != "" {
+		cache.Dir = filepath.Join(cache.Dir, "profiles", o.profile)
+	}
+
+	// Without a session, the cache is only read, so its account does not matter.
+	session, _, _ := resolveSession(o.session, o.profile)
+	cache.Session = SessionID(session)
+
+	return cache, nil
+}
+
+// SessionID returns an identifier of the account of the given session cookie, suitable for InputCache.Session,
+// without revealing the cookie. It returns an empty string for an empty session.
+func SessionID(session string) string {
+	if session == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(session))
+
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hvpaiva/goaoc"
+)
+
+func TestInputCache(t *testing.T) {
+	cache := goaoc.InputCache{Dir: t.TempDir()}
+
+	if _, ok, err := cache.Load(2024, 7); ok || err != nil {
+		t.Fatalf("Expected no cached input, but got ok=%v, err=%v", ok, err)
+	}
+
+	if err := cache.Store(2024, 7, "1 2 3\n"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if input, ok, err := cache.Load(2024, 7); !ok || err != nil || input != "1 2 3\n" {
+		t.Fatalf("Expected the cached input, but got %q (ok=%v, err=%v)", input, ok, err)
+	}
+
+	if expected := filepath.Join(cache.Dir, "2024", "day07.txt"); cache.Path(2024, 7) != expected {
+		t.Errorf("Expected path '%s', but got '%s'", expected, cache.Path(2024, 7))
+	}
+
+	for range 2 {
+		if err := cache.Invalidate(2024, 7); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if _, ok, _ := cache.Load(2024, 7); ok {
+		t.Error("Expected the input to be invalidated")
+	}
+}
+
+func TestInvalidateCache(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("GOAOC_CACHE_DIR", dir)
+
+	cache := goaoc.InputCache{Dir: dir}
+	if err := cache.Store(2023, 1, "input"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := goaoc.InvalidateCache(2023, 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, ok, _ := cache.Load(2023, 1); ok {
+		t.Error("Expected the input to be removed from the default cache")
+	}
+}
+
+func TestInputCacheFresh(t *testing.T) {
+	dir := t.TempDir()
+	personal := goaoc.InputCache{Dir: dir, Session: goaoc.SessionID("personal")}
+	work := goaoc.InputCache{Dir: dir, Session: goaoc.SessionID("work")}
+
+	for _, year := range []int{2024, 2999} {
+		if err := personal.Store(year, 1, "input"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		cache    goaoc.InputCache
+		year     int
+		expected bool
+	}{
+		{"SameSession", personal, 2024, true},
+		{"AnySession", goaoc.InputCache{Dir: dir}, 2024, true},
+		{"OtherSession", work, 2024, false},
+		{"BeforeUnlock", personal, 2999, false},
+		{"NotCached", personal, 2023, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fresh, err := tc.cache.Fresh(tc.year, 1)
+			if err != nil || fresh != tc.expected {
+				t.Errorf("Expected fresh to be %v, but got %v (err: %v)", tc.expected, fresh, err)
+			}
+		})
+	}
+}
+
+func TestCachedProviderRefreshesStaleInputs(t *testing.T) {
+	dir := t.TempDir()
+	if err := (goaoc.InputCache{Dir: dir, Session: goaoc.SessionID("personal")}).Store(2024, 7, "personal input"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	provider := goaoc.InputProviderFunc(func(_ context.Context, _, _ int) (string, error) { return "work input", nil })
+	cached := goaoc.CachedProvider(goaoc.InputCache{Dir: dir, Session: goaoc.SessionID("work")}, provider)
+
+	input, err := cached.Fetch(context.Background(), 2024, 7)
+	if err != nil || input != "work input" {
+		t.Fatalf("Expected the input of the current session, but got %q (err: %v)", input, err)
+	}
+
+	info, ok, err := (goaoc.InputCache{Dir: dir}).Info(2024, 7)
+	if err != nil || !ok || info.Session != goaoc.SessionID("work") {
+		t.Errorf("Expected the refreshed input to be stored for the current session, but got %+v (err: %v)", info, err)
+	}
+}
+
+func TestInputCacheChecksum(t *testing.T) {
+	cache := goaoc.InputCache{Dir: t.TempDir()}
+	if err := cache.Store(2024, 7, "1 2 3"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := os.WriteFile(cache.Path(2024, 7), []byte("1 2"), 0o600); err != nil {
+		t.Fatalf("Unexpected error truncating the input: %v", err)
+	}
+
+	if _, ok, err := cache.Load(2024, 7); ok || !errors.Is(err, goaoc.ErrCorruptedCache) {
+		t.Fatalf("Expected ErrCorruptedCache, but got ok=%v, err=%v", ok, err)
+	}
+
+	provider := goaoc.InputProviderFunc(func(_ context.Context, _, _ int) (string, error) { return "1 2 3", nil })
+
+	input, err := goaoc.CachedProvider(cache, provider).Fetch(context.Background(), 2024, 7)
+	if err != nil || input != "1 2 3" {
+		t.Fatalf("Expected the corrupted input to be fetched again, but got %q (err: %v)", input, err)
+	}
+
+	if input, ok, err := cache.Load(2024, 7); !ok || err != nil || input != "1 2 3" {
+		t.Errorf("Expected the fetched input to replace the corrupted one, but got %q (ok=%v, err=%v)", input, ok, err)
+	}
+}
+
+func TestInputCacheConcurrentStores(t *testing.T) {
+	cache := goaoc.InputCache{Dir: t.TempDir()}
+	inputs := []string{strings.Repeat("a", 1<<16), strings.Repeat("b", 1<<17)}
+
+	var wg sync.WaitGroup
+
+	for i := range 8 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			if err := cache.Store(2024, 7, inputs[i%2]); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			input, ok, err := cache.Load(2024, 7)
+			if err != nil && !errors.Is(err, goaoc.ErrCorruptedCache) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if ok && input != inputs[0] && input != inputs[1] {
+				t.Errorf("Expected a complete input, but got %d bytes", len(input))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	entries, err := os.ReadDir(filepath.Join(cache.Dir, "2024"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Errorf("Expected only the input and its information in the cache, but got %d files", len(entries))
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/hvpaiva/goaoc/internal/htmltext"
+)
+
+var (
+	// articleTag matches the tags of an article of a puzzle page, capturing whether it is a closing tag and its name.
+	articleTag = regexp.MustCompile(`<(/?)([a-zA-Z0-9]+)[^>]*>`)
+
+	// headingDashes matches the dashes framing the headings of a puzzle page, e.g. "--- Part Two ---".
+	headingDashes = regexp.MustCompile(`(<h2[^>]*>)---\s*(.*?)\s*---(</h2>)`)
+)
+
+// FetchDescription downloads the page of the puzzle of the given year and day from adventofcode.com,
+// authenticated by the session cookie resolved by ResolveSession, and returns its description as Markdown
+// readable in a terminal: headings, paragraphs, lists, inline code and emphasis are converted, and the examples
+// are kept verbatim in fenced code blocks. The description of part 2 is included once part 1 is solved.
+// The request options, such as WithHTTPClient, apply to the download, like for Submit.
+//
+// Example:
+//
+//	description, err := FetchDescription(ctx, 2024, 7)
+func FetchDescription(ctx context.Context, year, day int, options ...RunOption) (string, error) {
+	var opts runOptions
+	if err := applyOptions(&opts, options...); err != nil {
+		return "", err
+	}
+
+	return opts.aocProvider().description(ctx, year, day)
+}
+
+// description downloads the page of the puzzle of the given year and day and renders its description.
+func (p aocProvider) description(ctx context.Context, year, day int) (string, error) {
+	page, err := p.page(ctx, year, day)
+	if err != nil {
+		return "", err
+	}
+
+	return renderDescription(page), nil
+}
+
+// renderDescription returns the articles of a puzzle page as Markdown.
+func renderDescription(page string) string {
+	var md markdown
+
+	for _, article := range puzzleArticles(page) {
+		md.render(article)
+		md.block()
+	}
+
+	return strings.TrimSpace(string(md)) + "\n"
+}
+
+// renderPart returns the article of the given part of a puzzle page as Markdown, and reports whether the page
+// has it, as the article of part 2 is only served once part 1 is solved.
+func renderPart(page string, part Part) (string, bool) {
+	articles := puzzleArticles(page)
+	if int(part) > len(articles) {
+		return "", false
+	}
+
+	var md markdown
+
+	md.render(articles[part-1])
+
+	return strings.TrimSpace(string(md)) + "\n", true
+}
+
+// puzzleArticles returns the HTML of the articles of a puzzle page, which hold the description of each part,
+// without the dashes framing their headings.
+func puzzleArticles(page string) []string {
+	return htmltext.Articles(headingDashes.ReplaceAllString(page, "$1$2$3"))
+}
+
+// markdown is a Markdown document rendered from the HTML of a puzzle page.
+type markdown []byte
+
+// render appends the Markdown of the HTML fragment to the document.
+func (md *markdown) render(fragment string) {
+	pre := false
+	last := 0
+
+	for _, match := range articleTag.FindAllStringSubmatchIndex(fragment, -1) {
+		md.text(fragment[last:match[0]], pre)
+		last = match[1]
+
+		closing, name := fragment[match[2]:match[3]] == "/", strings.ToLower(fragment[match[4]:match[5]])
+
+		switch {
+		case name == "pre" && !closing:
+			md.block()
+			*md = append(*md, "```\n"...)
+			pre = true
+		case name == "pre":
+			md.line()
+			*md = append(*md, "```"...)
+			md.block()
+			pre = false
+		case pre:
+			// Examples are kept verbatim, without their highlighting.
+		case name == "h2" && !closing:
+			md.block()
+			*md = append(*md, "## "...)
+		case name == "h2", name == "p", name == "ul", name == "ol":
+			md.block()
+		case name == "li" && !closing:
+			md.line()
+			*md = append(*md, "- "...)
+		case name == "code":
+			*md = append(*md, '`')
+		case name == "em":
+			*md = append(*md, '*')
+		}
+	}
+
+	md.text(fragment[last:], pre)
+}
+
+// text appends the text between two tags, with its white space collapsed, unless it is part of an example.
+func (md *markdown) text(text string, pre bool) {
+	if pre {
+		*md = append(*md, html.UnescapeString(text)...)
+
+		return
+	}
+
+	text = htmltext.Collapse(text)
+	if len(*md) == 0 || strings.HasSuffix(string(*md), "\n") || strings.HasSuffix(string(*md), " ") {
+		text = strings.TrimLeft(text, " ")
+	}
+
+	*md = append(*md, html.UnescapeString(text)...)
+}
+
+// line ends the current line, unless the document is empty or already at the start of a line.
+func (md *markdown) line() {
+	*md = markdown(strings.TrimRight(string(*md), " "))
+
+	if len(*md) > 0 && !strings.HasSuffix(string(*md), "\n") {
+		*md = append(*md, '\n')
+	}
+}
+
+// block ends the current block with a blank line, unless the document is empty or already at the start of a block.
+func (md *markdown) block() {
+	md.line()
+
+	if len(*md) > 0 && !strings.HasSuffix(string(*md), "\n\n") {
+		*md = append(*md, '\n')
+	}
+}
+
+// describeInConsole reports whether the -puzzle flag is set, when reader is a DefaultConsoleManager.
+func describeInConsole(reader InputReader) (bool, error) {
+	switch console := reader.(type) {
+	case DefaultConsoleManager:
+		return getDescribeInFlag(console.Env)
+	case *DefaultConsoleManager:
+		return getDescribeInFlag(console.Env)
+	default:
+		return false, nil
+	}
+}
+
+// writeDescription writes the description of the puzzle of the known year and day to the stdout of the console
+// manager, or os.Stdout for other OutputWriters, when the -puzzle flag is set.
+func (o *runOptions) writeDescription(ctx context.Context) error {
+	year, day, err := o.puzzle(0, 0)
+	if err != nil {
+		return err
+	}
+
+	if o.isOffline() {
+		return fmt.Errorf("%w: cannot download the description of %d day %d", ErrOffline, year, day)
+	}
+
+	description, err := o.aocProvider().description(ctx, year, day)
+	if err != nil {
+		return err
+	}
+
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if _, err := fmt.Fprint(o.stdout(), description); err != nil {
+		return IOWriteError{Err: err}
+	}
+
+	return nil
+}
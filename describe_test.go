@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestRenderDescription(t *testing.T) {
+	page := `<main><article class="day-desc"><h2>--- Day 7: Bridge Repair ---</h2><p>The Historians take you to a
+familiar <a href="/2022/day/9">rope bridge</a> over a river.</p>
+<p>For example:</p>
+<pre><code>190: 10 19
+<em>3267</em>: 81   40 27
+</code></pre>
+<ul>
+<li><code>190: 10 19</code> has only one position.</li>
+<li>The <em>total calibration result</em> is &lt;3749&gt;.</li>
+</ul>
+</article>
+<p>Your puzzle answer was <code>3749</code>.</p><article class="day-desc"><h2 id="part2">--- Part Two ---</h2><p>The engineers seem concerned.</p></article></main>`
+
+	expected := "## Day 7: Bridge Repair\n\n" +
+		"The Historians take you to a familiar rope bridge over a river.\n\n" +
+		"For example:\n\n" +
+		"```\n190: 10 19\n3267: 81   40 27\n```\n\n" +
+		"- `190: 10 19` has only one position.\n" +
+		"- The *total calibration result* is <3749>.\n\n" +
+		"## Part Two\n\n" +
+		"The engineers seem concerned.\n"
+
+	if description := renderDescription(page); description != expected {
+		t.Errorf("Expected:\n%s\nbut got:\n%s", expected, description)
+	}
+}
+
+func TestDescribeFlag(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	downloads := 0
+	server := newInputServer(t, http.StatusOK, &downloads)
+	stdout := new(bytes.Buffer)
+	manager := DefaultConsoleManager{Env: mockEnv([]string{"-puzzle"}, "", stdout)}
+	challenges := []ChallengeE[int]{
+		func(string) (int, error) { return 0, errors.New("part 1 ran") },
+		func(string) (int, error) { return 0, errors.New("part 2 ran") },
+	}
+
+	opts := runOptions{baseURL: server.URL}
+
+	results, err := runWith(context.Background(), &opts, challenges, WithManager(manager), WithInputDownload(2024, 7))
+	if err != nil || results != nil {
+		t.Fatalf("Expected no results and no error, but got %v (err: %v)", results, err)
+	}
+
+	expected := "## Day 7: Bridge & Repair\n\nFor example:\n\n```\n1 2\n3 < 4\n```\n\nThen:\n\n```\n5\n```\n"
+	if stdout.String() != expected {
+		t.Errorf("Expected the description %q, but got %q", expected, stdout.String())
+	}
+
+	if downloads != 0 {
+		t.Errorf("Expected the input not to be downloaded, but it was downloaded %d times", downloads)
+	}
+
+	opts = runOptions{baseURL: server.URL}
+
+	_, err = runWith(context.Background(), &opts, challenges, WithManager(manager), WithInputDownload(2024, 7), WithOffline())
+	if !errors.Is(err, ErrOffline) {
+		t.Errorf("Expected ErrOffline, but got: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// WithInputDiscovery creates a RunOption that reads the input of the given year and day from a file
+// located by convention, relative to the root of the module containing the working directory:
+// inputs/<year>/day<DD>.txt first, then day<DD>/input.txt. Without a go.mod file, the working directory
+// is used as the root. The file is read and trimmed like with WithInputFile, and a missing input fails
+// with ErrInputFileNotFound, listing the paths that were looked up. A zero year or day is taken from
+// WithYear and WithDay, or inferred from the directory names.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDiscovery(2024, 7))
+func WithInputDiscovery(year, day int) RunOption {
+	return WithInputProvider(DiscoveryProvider(), year, day)
+}
+
+// DiscoveryProvider returns an InputProvider that reads the input from the file located by convention in the
+// module root of the working directory, as described in WithInputDiscovery.
+func DiscoveryProvider() InputProvider {
+	return InputProviderFunc(func(_ context.Context, year, day int) (string, error) {
+		dir, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+
+		path, err := discoverInput(dir, year, day)
+		if err != nil {
+			return "", err
+		}
+
+		return fileInput(os.ReadFile(path))
+	})
+}
+
+// discoverInput returns the path of the first existing conventional input file of the given year and day,
+// in the module root of dir.
+func discoverInput(dir string, year, day int) (string, error) {
+	return findFile(inputCandidates(dir, year, day))
+}
+
+// inputCandidates returns the conventional paths of the input file of the given year and day, in order,
+// in the module root of dir.
+func inputCandidates(dir string, year, day int) []string {
+	root := moduleRoot(dir)
+
+	return []string{
+		filepath.Join(root, "inputs", strconv.Itoa(year), fmt.Sprintf("day%02d.txt", day)),
+		filepath.Join(root, fmt.Sprintf("day%02d", day), "input.txt"),
+	}
+}
+
+// findFile returns the first of the candidate paths that exists. If none does, it fails with
+// ErrInputFileNotFound, listing the candidates.
+func findFile(candidates []string) (string, error) {
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+
+	return "", fmt.Errorf("%w: looked for %s", ErrInputFileNotFound, strings.Join(candidates, ", "))
+}
+
+// moduleRoot returns the closest directory, from dir up, that contains a go.mod file, or dir if there is none.
+func moduleRoot(dir string) string {
+	for current := dir; ; {
+		if _, err := os.Stat(filepath.Join(current, "go.mod")); err == nil {
+			return current
+		}
+
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+
+		current = parent
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatalf("Unexpected error creating %s: %v", filepath.Dir(path), err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Unexpected error writing %s: %v", path, err)
+	}
+}
+
+func TestDiscoverInput(t *testing.T) {
+	root := t.TempDir()
+	workDir := filepath.Join(root, "day07")
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/aoc\n")
+	writeFile(t, filepath.Join(workDir, "input.txt"), "day dir")
+
+	path, err := discoverInput(workDir, 2024, 7)
+	if err != nil || path != filepath.Join(workDir, "input.txt") {
+		t.Fatalf("Expected the day directory input, but got '%s' (err: %v)", path, err)
+	}
+
+	inputs := filepath.Join(root, "inputs", "2024", "day07.txt")
+	writeFile(t, inputs, "inputs dir")
+
+	path, err = discoverInput(workDir, 2024, 7)
+	if err != nil || path != inputs {
+		t.Fatalf("Expected the inputs directory to take precedence, but got '%s' (err: %v)", path, err)
+	}
+}
+
+func TestDiscoverInputNotFound(t *testing.T) {
+	root := t.TempDir()
+
+	_, err := discoverInput(root, 2024, 7)
+	if !errors.Is(err, ErrInputFileNotFound) {
+		t.Fatalf("Expected ErrInputFileNotFound, but got: %v", err)
+	}
+
+	for _, path := range []string{filepath.Join(root, "inputs", "2024", "day07.txt"), filepath.Join(root, "day07", "input.txt")} {
+		if !strings.Contains(err.Error(), path) {
+			t.Errorf("Expected the error to list '%s', but got: %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,377 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+	"github.com/hvpaiva/goaoc/internal/buildinfo"
+)
+
+// puzzlePage is a puzzle page served by newInputServer, with two examples and the first part solved.
+const puzzlePage = `<article class="day-desc"><h2>--- Day 7: Bridge &amp; Repair ---</h2><p>For example:</p><pre><code>1 2
+<em>3</em> &lt; 4
+</code></pre><p>Then:</p><pre><code>5</code></pre></article><p>Your puzzle answer was <code>3749</code>.</p>`
+
+// contactAgent is the User-Agent of the requests with the contact me@example.com.
+var contactAgent = "goaoc/" + buildinfo.Version() + " (+https://github.com/hvpaiva/goaoc; me@example.com)"
+
+func newInputServer(t *testing.T, status int, downloads *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/2024/day/7" {
+			_, _ = w.Write([]byte(puzzlePage))
+
+			return
+		}
+
+		*downloads++
+
+		if r.UserAgent() != contactAgent {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" || r.URL.Path != "/2024/day/7/input" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("1 2 3\n"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestDownloadInput(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	downloads := 0
+	server := newInputServer(t, http.StatusOK, &downloads)
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir(), contact: "me@example.com"}
+
+	for range 2 {
+		input, err := opts.downloadInput(context.Background(), 2024, 7)
+		if err != nil || input != "1 2 3" {
+			t.Fatalf("Expected input '1 2 3', but got %q (err: %v)", input, err)
+		}
+	}
+
+	if downloads != 1 {
+		t.Errorf("Expected the input to be downloaded once, but it was downloaded %d times", downloads)
+	}
+
+	cache := InputCache{Dir: opts.cacheDir}
+	for n, expected := range map[int]string{1: "1 2\n3 < 4", 2: "5"} {
+		if example, err := os.ReadFile(cache.ExamplePath(2024, 7, n)); err != nil || string(example) != expected {
+			t.Errorf("Expected example %d to be %q, but got %q (err: %v)", n, expected, example, err)
+		}
+	}
+
+	if err := (InputCache{Dir: opts.cacheDir}).Invalidate(2024, 7); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := opts.downloadInput(context.Background(), 2024, 7); err != nil || downloads != 2 {
+		t.Errorf("Expected the invalidated input to be downloaded again, but got %d downloads (err: %v)", downloads, err)
+	}
+}
+
+func TestDownloadInputErrors(t *testing.T) {
+	t.Run("MissingSession", func(t *testing.T) {
+		t.Setenv("GOAOC_SESSION", "")
+
+		opts := runOptions{cacheDir: t.TempDir()}
+		if _, err := opts.downloadInput(context.Background(), 2024, 7); !errors.Is(err, ErrMissingSession) {
+			t.Errorf("Expected ErrMissingSession, but got: %v", err)
+		}
+	})
+
+	t.Run("HTTPError", func(t *testing.T) {
+		t.Setenv("GOAOC_SESSION", "secret")
+		t.Setenv("GOAOC_CONTACT", "me@example.com")
+
+		downloads := 0
+		server := newInputServer(t, http.StatusNotFound, &downloads)
+		opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+
+		_, err := opts.downloadInput(context.Background(), 2024, 7)
+		if !errors.Is(err, ErrDownloadFailed) || err.Error() != "failed to download input: 404 Not Found, the puzzle may not be unlocked yet" {
+			t.Errorf("Expected ErrDownloadFailed, but got: %v", err)
+		}
+
+		if _, ok, _ := (InputCache{Dir: opts.cacheDir}).Load(2024, 7); ok {
+			t.Error("Expected a failed download not to be cached")
+		}
+
+		if _, err := opts.downloadInput(context.Background(), 2024, 7); !errors.Is(err, ErrDownloadFailed) || downloads != 1 {
+			t.Errorf("Expected the 404 not to be requested again, but got %d downloads (err: %v)", downloads, err)
+		}
+	})
+}
+
+func TestDownloadIntegrity(t *testing.T) {
+	testCases := []struct {
+		name      string
+		handler   http.HandlerFunc
+		expectErr error
+	}{
+		{"HTMLPage", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("<!DOCTYPE html>\n<html><body>Puzzle inputs differ by user.</body></html>"))
+		}, ErrHTMLInput},
+		{"Truncated", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Length", "100")
+			_, _ = w.Write([]byte("1 2 3\n"))
+		}, ErrDownloadFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			t.Cleanup(server.Close)
+
+			provider := aocProvider{baseURL: server.URL, session: "secret"}
+			if _, err := provider.Fetch(context.Background(), 2024, 7); !errors.Is(err, tc.expectErr) {
+				t.Errorf("Expected %v, but got: %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestDownloadWithInvalidSession(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"LoggedOut", http.StatusBadRequest, "Puzzle inputs differ by user.  Please log in to get your puzzle input.\n"},
+		{"LoginPage", http.StatusOK, "<html><head><title>Advent of Code</title></head><body>[Log In]</body></html>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			t.Cleanup(server.Close)
+
+			provider := aocProvider{baseURL: server.URL, session: "expired"}
+
+			_, err := provider.Fetch(context.Background(), 2024, 7)
+			if !errors.Is(err, ErrSessionExpired) || !strings.HasSuffix(err.Error(), "with the session of the WithSession option") {
+				t.Errorf("Expected ErrSessionExpired with the session source, but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestSessionExpired(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte("<article><p>To play, please identify yourself via one of these services:</p></article>"))
+
+			return
+		}
+
+		http.Redirect(w, r, "/2024/auth/login", http.StatusFound)
+	}))
+	t.Cleanup(server.Close)
+
+	provider := aocProvider{baseURL: server.URL, session: "expired"}
+
+	if _, err := provider.page(context.Background(), 2024, 7); !errors.Is(err, ErrSessionExpired) {
+		t.Errorf("Expected ErrSessionExpired for a redirect to the login page, but got: %v", err)
+	}
+
+	if _, err := provider.submit(context.Background(), 2024, 7, 1, "42"); !errors.Is(err, ErrSessionExpired) ||
+		!strings.Contains(err.Error(), "copy the value of the session cookie") {
+		t.Errorf("Expected ErrSessionExpired with renewal guidance for a request to log in, but got: %v", err)
+	}
+}
+
+func TestPageWithoutSession(t *testing.T) {
+	t.Setenv("AOC_SESSION", "")
+	t.Setenv("GOAOC_SESSION", "")
+
+	const page = "<main><article><h2>--- Day 7: Bridge Repair ---</h2></article>" +
+		"<p>To play, please identify yourself via one of these services:</p></main>"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(page))
+	}))
+	t.Cleanup(server.Close)
+
+	provider := aocProvider{baseURL: server.URL}
+
+	if content, err := provider.page(context.Background(), 2024, 7); err != nil || content != page {
+		t.Errorf("Expected the public puzzle page, but got %q (err: %v)", content, err)
+	}
+
+	if _, err := provider.Fetch(context.Background(), 2024, 7); !errors.Is(err, ErrMissingSession) {
+		t.Errorf("Expected ErrMissingSession for the input, but got: %v", err)
+	}
+}
+
+func TestWithInputURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = w.Write([]byte("mirrored\n"))
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		name      string
+		header    http.Header
+		expected  string
+		expectErr error
+	}{
+		{"Authorized", http.Header{"Authorization": {"Bearer token"}}, "mirrored\n", nil},
+		{"Unauthorized", nil, "", ErrDownloadFailed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts runOptions
+			if err := WithInputURL(server.URL, tc.header)(&opts); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			input, err := opts.loadInput(context.Background())
+			if !errors.Is(err, tc.expectErr) || input != tc.expected {
+				t.Errorf("Expected input %q and error %v, but got %q and %v", tc.expected, tc.expectErr, input, err)
+			}
+		})
+	}
+}
+
+// roundTripperFunc is an http.RoundTripper answering requests with a function.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWithTransport(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	var requested []string
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requested = append(requested, req.URL.String())
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("1 2 3\n")), Request: req}, nil
+	})
+
+	opts := runOptions{baseURL: "http://aoc.invalid", cacheDir: t.TempDir()}
+	for _, option := range []RunOption{WithTransport(transport), WithInputURL("http://mirror.invalid/7", nil)} {
+		if err := option(&opts); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if input, err := opts.input(context.Background()); err != nil || input != "1 2 3\n" {
+		t.Fatalf("Expected the input of the transport, but got %q (err: %v)", input, err)
+	}
+
+	if input, err := opts.downloadInput(context.Background(), 2024, 7); err != nil || input != "1 2 3" {
+		t.Fatalf("Expected the input of the transport, but got %q (err: %v)", input, err)
+	}
+
+	if len(requested) < 2 || requested[0] != "http://mirror.invalid/7" || requested[1] != "http://aoc.invalid/2024/day/7/input" {
+		t.Errorf("Expected the downloads to go through the transport, but got %v", requested)
+	}
+}
+
+func TestWithUserAgent(t *testing.T) {
+	var agents []string
+
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		agents = append(agents, req.Header.Get("User-Agent"))
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(puzzlePage)), Request: req}, nil
+	})
+
+	opts := runOptions{baseURL: "http://aoc.invalid"}
+	if err := applyOptions(&opts, WithSession("secret"), WithTransport(transport), WithContact("me@example.com")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := opts.aocProvider().page(context.Background(), 2024, 7); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := applyOptions(&opts, WithUserAgent("mytool/1.2 (me@example.com)")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := opts.aocProvider().submit(context.Background(), 2024, 7, 1, "42"); err != nil && !errors.Is(err, ErrUnknownVerdict) {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{contactAgent, "mytool/1.2 (me@example.com)"}
+	if len(agents) != 2 || agents[0] != expected[0] || agents[1] != expected[1] {
+		t.Errorf("Expected the User-Agents %q, but got %q", expected, agents)
+	}
+}
+
+func TestWithClient(t *testing.T) {
+	fake := &aocapi.Fake{
+		Inputs:  map[aocapi.PuzzleKey]string{{Year: 2024, Day: 7}: "190: 10 19\n"},
+		Pages:   map[aocapi.PuzzleKey]string{{Year: 2024, Day: 7}: puzzlePage},
+		Answers: map[aocapi.AnswerKey]string{{Year: 2024, Day: 7, Part: 2}: "3749"},
+	}
+	stdout := new(bytes.Buffer)
+	challenges := []ChallengeE[int]{
+		func(string) (int, error) { return 0, nil },
+		func(input string) (int, error) {
+			if input != "190: 10 19" {
+				return 0, fmt.Errorf("unexpected input %q", input)
+			}
+
+			return 3749, nil
+		},
+	}
+
+	opts := runOptions{baseURL: "http://aoc.invalid", cacheDir: t.TempDir()}
+	manager := DefaultConsoleManager{Env: mockEnv([]string{}, "", stdout)}
+
+	_, err := runWith(context.Background(), &opts, challenges,
+		WithManager(manager), WithPart(2), WithInputDownload(2024, 7), WithSubmit(), WithClient(fake))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	for _, expected := range []string{"Day 7: Bridge & Repair ★☆\n", "The challenge result is 3749\n", "Part 2: correct"} {
+		if !strings.Contains(stdout.String(), expected) {
+			t.Errorf("Expected %q in the output, but got %q", expected, stdout.String())
+		}
+	}
+
+	if submissions := fake.Submissions(); len(submissions) != 1 || submissions[0].Answer != "3749" {
+		t.Errorf("Expected the answer to be submitted to the client, but got %+v", submissions)
+	}
+}
@@ -5,20 +5,35 @@
 package goaoc
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // InvalidPartError indicates an error that occurs when an invalid part number
-// is specified. Valid part numbers are 1 and 2.
+// is specified. Valid part numbers are 1 and 2, or 1 to Parts when the challenge has more parts.
 type InvalidPartError struct {
-	Part int
+	Part  int
+	Parts int
 }
 
 // Error implements the error interface for InvalidPartError.
 // It returns a descriptive error message suitable for logging and debugging.
 func (e InvalidPartError) Error() string {
-	return fmt.Sprintf("invalid part: %d. The valid parts are (1/2)", e.Part)
+	parts := e.Parts
+	if parts < 2 {
+		parts = 2
+	}
+
+	valid := make([]string, parts)
+	for i := range valid {
+		valid[i] = strconv.Itoa(i + 1)
+	}
+
+	return fmt.Sprintf("invalid part: %d. The valid parts are (%s)", e.Part, strings.Join(valid, "/"))
 }
 
 // ErrInvalidPartType indicates an error that occurs when an invalid part type
@@ -30,6 +45,124 @@ var ErrInvalidPartType = errors.New("invalid part type. The part type allowed is
 // is expected to be provided by some means (flag, input, etc.).
 var ErrMissingPart = errors.New("no part specified, please provide a valid part")
 
+// ErrMissingInput indicates that no input was provided for the challenge,
+// typically when Solve is called without any input option.
+var ErrMissingInput = errors.New("no input specified, please provide the challenge input")
+
+// ErrInputFileNotFound indicates that the input file given to WithInputFile or WithInputFS does not exist,
+// typically because the puzzle input was not downloaded yet. It is returned wrapping the underlying
+// fs.ErrNotExist error, which holds the path.
+var ErrInputFileNotFound = errors.New("input file not found, please download the puzzle input")
+
+// ErrMissingSession indicates that an input must be downloaded, but no session cookie was found
+// in any of the sources of ResolveSession. It is returned wrapped with the sources looked up.
+var ErrMissingSession = errors.New("no session specified, please set AOC_SESSION or call SetSession with your adventofcode.com session cookie")
+
+// ErrMissingFlagValue indicates that a command-line flag expecting a value, such as -profile, was given
+// none. It is returned wrapped with the flag.
+var ErrMissingFlagValue = errors.New("flag needs a value")
+
+// ErrInvalidProfile indicates that a profile name, see WithProfile, is not made of letters, digits, dashes
+// and underscores only. It is returned wrapped with the offending name.
+var ErrInvalidProfile = errors.New("invalid profile name, use only letters, digits, dashes and underscores")
+
+// ErrKeyringUnsupported indicates that the session cannot be stored in the keyring of the running OS.
+var ErrKeyringUnsupported = errors.New("storing the session in the keyring is not supported on this system")
+
+// ErrDownloadFailed indicates that adventofcode.com, or the endpoint given to WithInputURL, did not
+// return the input. It is returned wrapped with the HTTP status of the response.
+var ErrDownloadFailed = errors.New("failed to download input")
+
+// ErrSessionExpired indicates that adventofcode.com did not accept the session of a request, because it has
+// expired or is wrong: it redirected the request to its login page or asked to log in. Its message walks
+// through renewing the session. It is returned wrapped with the source of the session, see ResolveSession.
+var ErrSessionExpired = errors.New("adventofcode.com session expired or invalid; to renew it, " +
+	"(1) log in to https://adventofcode.com in your browser, " +
+	"(2) open the developer tools and copy the value of the session cookie of adventofcode.com " +
+	"(Application > Cookies in Chrome, Storage > Cookies in Firefox), " +
+	"(3) set it in AOC_SESSION, or save it with SetSession")
+
+// ErrHTMLInput indicates that adventofcode.com answered an input download with an HTML page, such as a login
+// page, instead of the input. It is returned wrapped in ErrSessionExpired.
+var ErrHTMLInput = errors.New("downloaded input is an HTML page")
+
+// ErrCorruptedCache indicates that a cached input does not match the checksum stored with it, e.g. because
+// the file was truncated or edited. It is returned wrapped with the path of the input.
+var ErrCorruptedCache = errors.New("cached input does not match its checksum")
+
+// ErrSubmitFailed indicates that adventofcode.com did not accept the submission of an answer, see Submit.
+// It is returned wrapped with the HTTP status of the response.
+var ErrSubmitFailed = errors.New("failed to submit answer")
+
+// ErrNotifierUnsupported indicates that DesktopNotifier cannot show notifications on the running OS.
+// It is returned wrapped with the name of the OS.
+var ErrNotifierUnsupported = errors.New("desktop notifications are not supported on this system")
+
+// ErrInvalidSubmitMode indicates that WithAutoSubmit was given a mode other than Confirm, NoConfirm and DryRun.
+var ErrInvalidSubmitMode = errors.New("invalid submit mode")
+
+// ErrKnownWrongAnswer indicates that an answer was not submitted, because it is known to be wrong from
+// previous submissions, see Submit. It is returned wrapped with the reason.
+var ErrKnownWrongAnswer = errors.New("answer is known to be wrong")
+
+// ErrUnknownVerdict indicates that the response of adventofcode.com to a submitted answer was not recognized,
+// see Submit. It is returned wrapped with the message of the response.
+var ErrUnknownVerdict = errors.New("unknown verdict of submitted answer")
+
+// ErrOffline indicates that an input had to be downloaded while offline, see WithOffline, and was
+// not found locally. It is returned wrapped with the paths that were looked up.
+var ErrOffline = errors.New("offline and the input is not available locally")
+
+// ErrInvalidYear indicates that WithYear was given a year before the first Advent of Code, in 2015.
+var ErrInvalidYear = errors.New("invalid year, Advent of Code started in 2015")
+
+// ErrInvalidDay indicates that WithDay was given a day out of the 1 to 25 range.
+var ErrInvalidDay = errors.New("invalid day, it must be between 1 and 25")
+
+// ErrUnknownPuzzle indicates that an input option needs the year and day of the puzzle, but they were
+// neither given, set with WithYear and WithDay, nor inferred from the directory names.
+var ErrUnknownPuzzle = errors.New("unknown puzzle, please set its year and day with WithYear and WithDay")
+
+// ErrInvalidSeed indicates that the GOAOC_SEED environment variable, the seed of WithGeneratedInput, is not
+// an integer. It is returned wrapped with the parsing error.
+var ErrInvalidSeed = errors.New("invalid GOAOC_SEED, it must be an integer")
+
+// ErrInvalidSSHURL indicates that WithInputSSH was given a URL other than ssh://[user@]host[:port]/path.
+// It is returned wrapped with the offending URL.
+var ErrInvalidSSHURL = errors.New("invalid SSH URL, expected ssh://[user@]host[:port]/path")
+
+// ErrNothingToWatch indicates that WithWatch was used without an input file to watch, such as the one
+// of WithInputFile or WithSample.
+var ErrNothingToWatch = errors.New("nothing to watch, the watch mode needs an input file from WithInputFile or WithSample")
+
+// ErrPartNotImplemented indicates that the selected part has no challenge function (it is nil),
+// typically because part 2 has not been written yet. It is returned wrapped with the part number.
+var ErrPartNotImplemented = errors.New("challenge part not implemented yet")
+
+// ErrUnexpectedPart indicates an inconsistent internal state, where the part selected for execution
+// has no matching challenge. It is returned wrapping an InvalidPartError with the offending part.
+var ErrUnexpectedPart = errors.New("unexpected part selected for execution")
+
+// OptionError indicates that a RunOption failed to be applied, aborting the run.
+// The underlying error can be retrieved for detailed inspection if necessary.
+type OptionError struct {
+	Err error
+}
+
+// Error implements the error interface for OptionError.
+// It provides a message indicating which option error aborted the run.
+func (e OptionError) Error() string {
+	return fmt.Sprintf("invalid run option: %v", e.Err)
+}
+
+// Unwrap allows access to the underlying error, following Go 1.13's error unwrapper design.
+func (e OptionError) Unwrap() error {
+	return e.Err
+}
+
+// ErrNegativeRetries indicates that WithRetries or WithDownloadRetries was given a negative number of retries.
+var ErrNegativeRetries = errors.New("the number of retries must not be negative")
+
 // IOReadError indicates a failure during input operations, such as reading
 // from a file or receiving input from the console. The underlying error
 // can be retrieved for detailed inspection if necessary.
@@ -65,3 +198,210 @@ func (e IOWriteError) Error() string {
 func (e IOWriteError) Unwrap() error {
 	return e.Err
 }
+
+// ChallengeError indicates that a ChallengeE function returned an error.
+// It records which part failed, and the underlying error can be retrieved for detailed inspection.
+type ChallengeError struct {
+	Part Part
+	Err  error
+}
+
+// Error implements the error interface for ChallengeError.
+// It provides a message indicating which part of the challenge failed.
+func (e ChallengeError) Error() string {
+	return fmt.Sprintf("challenge part %d failed: %v", e.Part, e.Err)
+}
+
+// Unwrap allows access to the underlying error, following Go 1.13's error unwrapper design.
+func (e ChallengeError) Unwrap() error {
+	return e.Err
+}
+
+// TimeoutError indicates that a challenge did not finish within the duration set by WithTimeout.
+// It matches context.DeadlineExceeded when checked with errors.Is.
+type TimeoutError struct {
+	Part    Part
+	Timeout time.Duration
+}
+
+// Error implements the error interface for TimeoutError.
+// It provides a message indicating which part timed out and the configured limit.
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("challenge part %d timed out after %s", e.Part, e.Timeout)
+}
+
+// Unwrap returns context.DeadlineExceeded, so timeouts can be detected as any other deadline.
+func (e TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// CooldownError indicates that an answer was not submitted, because adventofcode.com asked to wait before
+// submitting another answer to the puzzle, see Submit. The cooldown is recorded on disk, so it holds across runs.
+type CooldownError struct {
+	Remaining time.Duration
+}
+
+// Error implements the error interface for CooldownError.
+// It provides a message with the time left before another answer can be submitted.
+func (e CooldownError) Error() string {
+	return fmt.Sprintf("answer not submitted: wait %s before submitting another answer", e.Remaining.Round(time.Second))
+}
+
+// ChallengePanicError indicates that a challenge function panicked during its execution.
+// The panic is recovered and reported with the part being executed, the panic value and the
+// stack trace of the solution, trimmed to the frames between the panic and goaoc itself.
+type ChallengePanicError struct {
+	Part  Part
+	Value any
+	Stack string
+}
+
+// Error implements the error interface for ChallengePanicError.
+// It includes the panic value and the trimmed stack trace, so the panic location is not lost when logging.
+func (e ChallengePanicError) Error() string {
+	return fmt.Sprintf("challenge part %d panicked: %v\n%s", e.Part, e.Value, e.Stack)
+}
+
+// Unwrap returns the panic value when it is an error, such as a runtime.Error, and nil otherwise.
+func (e ChallengePanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// AnswerTypeError indicates that a typed option, such as WithAfterRun, was given a function whose
+// types do not match the answer type returned by the challenges.
+type AnswerTypeError struct {
+	Option   string
+	Expected string
+	Actual   string
+}
+
+// Error implements the error interface for AnswerTypeError.
+// It provides a message indicating the option and both types involved.
+func (e AnswerTypeError) Error() string {
+	return fmt.Sprintf("option %s expects %s, but got %s", e.Option, e.Expected, e.Actual)
+}
+
+// newAnswerTypeError builds an AnswerTypeError for an option expecting values of type E, given the value it received.
+func newAnswerTypeError[E any](option string, value any) AnswerTypeError {
+	return AnswerTypeError{Option: option, Expected: fmt.Sprintf("%T", *new(E)), Actual: fmt.Sprintf("%T", value)}
+}
+
+// ValidationError indicates that an answer was rejected by a validator registered with WithValidator.
+// The underlying error can be retrieved for detailed inspection if necessary.
+type ValidationError struct {
+	Part   Part
+	Answer string
+	Err    error
+}
+
+// Error implements the error interface for ValidationError.
+// It provides a message indicating the rejected answer and the reason.
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("answer %s for part %d rejected: %v", e.Answer, e.Part, e.Err)
+}
+
+// Unwrap allows access to the underlying error, following Go 1.13's error unwrapper design.
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// RegressionError indicates that an answer differs from the verified answer of the part, in the verify mode
+// enabled by WithVerify or the -verify flag, e.g. after a refactor broke the solution.
+type RegressionError struct {
+	Part     Part
+	Answer   string
+	Verified string
+}
+
+// Error implements the error interface for RegressionError.
+// It provides a message with the answer and the verified one.
+func (e RegressionError) Error() string {
+	return fmt.Sprintf("answer %s for part %d differs from the verified answer %s", e.Answer, e.Part, e.Verified)
+}
+
+// RetryError indicates that no answer of a part was accepted by the predicate given to WithRetries,
+// after all attempts were exhausted. Answer holds the answer of the last attempt.
+type RetryError struct {
+	Part     Part
+	Attempts int
+	Answer   string
+}
+
+// Error implements the error interface for RetryError.
+// It provides a message indicating the part, the number of attempts and the last answer.
+func (e RetryError) Error() string {
+	return fmt.Sprintf("no answer accepted for part %d after %d attempts, last answer was %s", e.Part, e.Attempts, e.Answer)
+}
+
+// MemoryLimitError indicates that the heap usage exceeded the limit set by WithMemoryLimit
+// while a part was running.
+type MemoryLimitError struct {
+	Part  Part
+	Limit uint64
+	Usage uint64
+}
+
+// Error implements the error interface for MemoryLimitError.
+// It provides a message indicating the part aborted, the heap usage observed and the configured limit.
+func (e MemoryLimitError) Error() string {
+	return fmt.Sprintf("challenge part %d aborted: heap usage of %d bytes exceeds the limit of %d bytes", e.Part, e.Usage, e.Limit)
+}
+
+// BatchError indicates that the run against the named input of a RunBatch failed.
+// It wraps the error of that input.
+type BatchError struct {
+	Name string
+	Err  error
+}
+
+// Error implements the error interface for BatchError.
+// It provides a message indicating the input that failed and the underlying error.
+func (e BatchError) Error() string {
+	return fmt.Sprintf("input %s failed: %v", e.Name, e.Err)
+}
+
+// Unwrap allows access to the underlying error, following Go 1.13's error unwrapper design.
+func (e BatchError) Unwrap() error {
+	return e.Err
+}
+
+// KeyringError indicates that the OS keyring command failed to store the session.
+// Output holds what the command printed, which usually explains the failure.
+type KeyringError struct {
+	Output string
+	Err    error
+}
+
+// Error implements the error interface for KeyringError.
+// It provides a message indicating the failure of the keyring command and its output.
+func (e KeyringError) Error() string {
+	return fmt.Sprintf("failed to store the session in the keyring: %v: %s", e.Err, e.Output)
+}
+
+// Unwrap allows access to the underlying error, following Go 1.13's error unwrapper design.
+func (e KeyringError) Unwrap() error {
+	return e.Err
+}
+
+// SSHError indicates that the ssh command reading the input of WithInputSSH failed, e.g. because the host
+// cannot be reached, the authentication failed or the remote file does not exist.
+// Output holds what the command printed to stderr, which usually explains the failure.
+type SSHError struct {
+	Output string
+	Err    error
+}
+
+// Error implements the error interface for SSHError.
+// It provides a message indicating the failure of the ssh command and its output.
+func (e SSHError) Error() string {
+	return fmt.Sprintf("failed to read the input over SSH: %v: %s", e.Err, e.Output)
+}
+
+// Unwrap allows access to the underlying error, following Go 1.13's error unwrapper design.
+func (e SSHError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/hvpaiva/goaoc/internal/htmltext"
+
+	"github.com/hvpaiva/goaoc/internal/atomicfile"
+)
+
+// exampleBlock matches the <pre><code> blocks of a puzzle page, which hold its examples.
+var exampleBlock = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+
+// ExamplePath returns the path of the n-th example, starting at 1, extracted from the puzzle page of the given
+// year and day, e.g. <Dir>/2024/day07/example1.txt.
+func (c InputCache) ExamplePath(year, day, n int) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), fmt.Sprintf("day%02d", day), fmt.Sprintf("example%d.txt", n))
+}
+
+// extractExamples returns the contents of the <pre><code> blocks of a puzzle page, without markup.
+func extractExamples(page string) []string {
+	matches := exampleBlock.FindAllStringSubmatch(page, -1)
+	examples := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		examples = append(examples, TrimTrailingNewline(htmltext.Text(match[1])))
+	}
+
+	return examples
+}
+
+// storePage downloads the puzzle page of the given year and day and stores it in the cache, see
+// InputCache.storePage, unless it is already stored.
+func (p aocProvider) storePage(ctx context.Context, cache InputCache, year, day int) error {
+	if _, err := os.Stat(cache.puzzlePath(year, day)); !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	page, err := p.page(ctx, year, day)
+	if err != nil {
+		return err
+	}
+
+	_, err = cache.storePage(year, day, page)
+
+	return err
+}
+
+// storePage stores the examples of the puzzle page of the given year and day, as candidate sample inputs for
+// WithSample, and its title and stars, for the banner, and returns how many examples there are.
+func (c InputCache) storePage(year, day int, page string) (int, error) {
+	examples := extractExamples(page)
+
+	for i, example := range examples {
+		path := c.ExamplePath(year, day, i+1)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return 0, err
+		}
+
+		if err := atomicfile.Write(path, []byte(example), 0o600); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(examples), c.storePuzzle(year, day, page)
+}
+
+// downloadExamples stores the examples of the puzzle of the known year and day in the cache, unless offline
+// or already stored, so WithSample can fall back to them.
+func (o *runOptions) downloadExamples(ctx context.Context) error {
+	if o.year == 0 || o.day == 0 || o.isOffline() {
+		return nil
+	}
+
+	cache, err := o.inputCache()
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(cache.ExamplePath(o.year, o.day, 1)); !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return o.aocProvider().storePage(ctx, cache, o.year, o.day)
+}
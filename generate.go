@@ -0,0 +1,75 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+)
+
+// WithGeneratedInput creates a RunOption that takes the challenge input from generate, called with a seed, so
+// solutions can be checked or benchmarked against synthetic inputs, such as worst cases of a configurable size.
+// The seed is the one set with WithSeed, or else the GOAOC_SEED environment variable, or else a random one;
+// a GOAOC_SEED that is not an integer fails with ErrInvalidSeed.
+// It is recorded in Result.Metadata, so a failing input can be generated again.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithGeneratedInput(func(seed int64) string {
+//	    return worstCase(rand.New(rand.NewPCG(uint64(seed), 0)), 100_000)
+//	}))
+func WithGeneratedInput(generate func(seed int64) string) RunOption {
+	return func(options *runOptions) error {
+		options.input = func(context.Context) (string, error) {
+			seed, err := options.inputSeed()
+			if err != nil {
+				return "", err
+			}
+
+			return generate(seed), nil
+		}
+		options.inputPath = ""
+
+		return nil
+	}
+}
+
+// WithSeed creates a RunOption that sets the seed given to the generator of WithGeneratedInput, e.g. to
+// generate again the input of a failed run.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithGeneratedInput(generate), WithSeed(42))
+func WithSeed(seed int64) RunOption {
+	return func(options *runOptions) error {
+		options.seed = &seed
+
+		return nil
+	}
+}
+
+// inputSeed returns the seed of a generated input, resolving it from the GOAOC_SEED environment variable or
+// at random when not set with WithSeed. The resolved seed is kept for the metadata of the run.
+func (o *runOptions) inputSeed() (int64, error) {
+	if o.seed != nil {
+		return *o.seed, nil
+	}
+
+	seed := rand.Int64()
+
+	if value := os.Getenv("GOAOC_SEED"); value != "" {
+		var err error
+		if seed, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidSeed, err)
+		}
+	}
+
+	o.seed = &seed
+
+	return seed, nil
+}
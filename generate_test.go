@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc_test
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc"
+	"github.com/hvpaiva/goaoc/mock"
+)
+
+func TestWithGeneratedInput(t *testing.T) {
+	generate := func(seed int64) string { return strconv.FormatInt(seed, 10) }
+
+	solve := func(t *testing.T, options ...goaoc.RunOption) (string, *int64, error) {
+		t.Helper()
+
+		var (
+			metadata bytes.Buffer
+			seed     *int64
+		)
+
+		mok := mock.NewManager("1", nil, nil)
+		options = append([]goaoc.RunOption{
+			goaoc.WithManager(&mok),
+			goaoc.WithGeneratedInput(generate),
+			goaoc.WithMetadata(&metadata),
+			goaoc.WithAfterRun(func(result goaoc.Result[string]) { seed = result.Metadata.Seed }),
+		}, options...)
+
+		err := goaoc.Solve(func(input string) string { return input }, nil, options...)
+
+		return mok.GetStdout() + metadata.String(), seed, err
+	}
+
+	t.Run("WithSeed", func(t *testing.T) {
+		output, seed, err := solve(t, goaoc.WithSeed(42))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if seed == nil || *seed != 42 || !strings.HasPrefix(output, "The challenge result is 42\n") || !strings.HasSuffix(output, " seed=42\n") {
+			t.Errorf("Expected the input generated with seed 42, but got %q", output)
+		}
+	})
+
+	t.Run("Environment", func(t *testing.T) {
+		t.Setenv("GOAOC_SEED", "7")
+
+		if output, _, err := solve(t); err != nil || !strings.HasPrefix(output, "The challenge result is 7\n") {
+			t.Errorf("Expected the input generated with seed 7, but got %q (err: %v)", output, err)
+		}
+
+		t.Setenv("GOAOC_SEED", "seven")
+
+		if _, _, err := solve(t); !errors.Is(err, goaoc.ErrInvalidSeed) {
+			t.Errorf("Expected ErrInvalidSeed, but got: %v", err)
+		}
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		t.Setenv("GOAOC_SEED", "")
+
+		output, seed, err := solve(t)
+		if err != nil || seed == nil {
+			t.Fatalf("Expected a random seed, but got %v (err: %v)", seed, err)
+		}
+
+		if expected := "The challenge result is " + strconv.FormatInt(*seed, 10) + "\n"; !strings.HasPrefix(output, expected) {
+			t.Errorf("Expected the input generated with the recorded seed, but got %q", output)
+		}
+	})
+}
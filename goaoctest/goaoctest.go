@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+// Package goaoctest provides helpers for the tests of Advent of Code solutions written with goaoc,
+// such as loading the puzzle inputs and examples kept as test fixtures.
+package goaoctest
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/hvpaiva/goaoc"
+)
+
+// Input returns the content of the fixture file at path, relative to the directory of the calling test file,
+// such as "testdata/day05.txt". Windows "\r\n" line breaks are replaced with "\n" and trailing line breaks
+// are trimmed, so fixtures behave the same whatever the editor or platform that saved them.
+// A missing or unreadable fixture fails the test immediately.
+//
+// Example:
+//
+//	func TestPartOne(t *testing.T) {
+//	    if answer := partOne(goaoctest.Input(t, "testdata/day05.txt")); answer != 143 {
+//	        t.Errorf("Expected 143, but got %d", answer)
+//	    }
+//	}
+func Input(tb testing.TB, path string) string {
+	tb.Helper()
+
+	// Without the -trimpath flag, the caller's file name is absolute; otherwise, tests run in the
+	// directory of their package, so the path is already relative to it.
+	if _, file, _, ok := runtime.Caller(1); ok && !filepath.IsAbs(path) && filepath.IsAbs(file) {
+		path = filepath.Join(filepath.Dir(file), path)
+	}
+
+	content, err := os.ReadFile(path)
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		tb.Fatalf("goaoctest: fixture %s not found, create it with the input of the puzzle", path)
+	case err != nil:
+		tb.Fatalf("goaoctest: cannot read fixture %s: %v", path, err)
+	}
+
+	return goaoc.TrimTrailingNewline(goaoc.CRLFToLF(string(content)))
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoctest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/goaoctest"
+)
+
+// recorder is a testing.TB recording the failures of a test instead of failing it.
+type recorder struct {
+	testing.TB
+	failure string
+}
+
+// Fatalf records the failure.
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.failure = fmt.Sprintf(format, args...)
+}
+
+func TestInput(t *testing.T) {
+	if input := goaoctest.Input(t, "testdata/fixture.txt"); input != "1 2\n3 4" {
+		t.Errorf("Expected the normalized fixture, but got %q", input)
+	}
+
+	rec := &recorder{TB: t}
+	goaoctest.Input(rec, "testdata/missing.txt")
+
+	if !strings.Contains(rec.failure, "testdata/missing.txt not found") {
+		t.Errorf("Expected the missing fixture to fail the test, but got %q", rec.failure)
+	}
+}
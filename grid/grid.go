@@ -0,0 +1,157 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+// Package grid provides a generic two-dimensional Grid, parsed from the rectangles of characters of the many
+// Advent of Code puzzles drawn on a map, and the Point coordinates of its cells.
+package grid
+
+import (
+	"fmt"
+	"iter"
+	"unicode/utf8"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+// Point is the coordinates of a cell: X is its column, from left to right, and Y its row, from top to bottom,
+// both starting at 0.
+type Point struct {
+	X, Y int
+}
+
+// Add returns the point p moved by q, e.g. p.Add(Up).
+func (p Point) Add(q Point) Point {
+	return Point{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+// The moves to the adjacent cells, to be added to a Point.
+var (
+	Up    = Point{X: 0, Y: -1}
+	Down  = Point{X: 0, Y: 1}
+	Left  = Point{X: -1, Y: 0}
+	Right = Point{X: 1, Y: 0}
+)
+
+// Grid is a rectangle of cells of type T, such as the runes of a puzzle map. Its zero value is an empty grid.
+type Grid[T any] struct {
+	width, height int
+
+	// cells holds the cells row by row.
+	cells []T
+}
+
+// New returns a grid of the given width and height, with the zero value of T in every cell.
+// A negative width or height panics.
+func New[T any](width, height int) *Grid[T] {
+	if width < 0 || height < 0 {
+		panic(fmt.Sprintf("grid: invalid size %dx%d", width, height))
+	}
+
+	return &Grid[T]{width: width, height: height, cells: make([]T, width*height)}
+}
+
+// Parse returns the grid of the lines of s, split like parse.Lines, converting each rune with convert.
+// A line of another length than the first one panics with its number, as the grid would not be a rectangle.
+//
+// Example:
+//
+//	heights := grid.Parse(input, func(r rune) int { return int(r - '0') })
+func Parse[T any](s string, convert func(r rune) T) *Grid[T] {
+	lines := parse.Lines(s)
+	if len(lines) == 0 {
+		return &Grid[T]{}
+	}
+
+	g := New[T](utf8.RuneCountInString(lines[0]), len(lines))
+
+	for y, line := range lines {
+		if width := utf8.RuneCountInString(line); width != g.width {
+			panic(fmt.Sprintf("grid: line %d has %d cells, expected %d", y+1, width, g.width))
+		}
+
+		x := 0
+		for _, r := range line {
+			g.cells[y*g.width+x] = convert(r)
+			x++
+		}
+	}
+
+	return g
+}
+
+// Runes returns the grid of the runes of s, like Parse without conversion.
+//
+// Example:
+//
+//	garden := grid.Runes(input)
+func Runes(s string) *Grid[rune] {
+	return Parse(s, func(r rune) rune { return r })
+}
+
+// Width returns the number of columns of the grid.
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows of the grid.
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// In reports whether p is a cell of the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.X >= 0 && p.X < g.width && p.Y >= 0 && p.Y < g.height
+}
+
+// Get returns the value of the cell at p, and reports whether p is in the grid. Outside of the grid, it returns
+// the zero value of T, so the neighbors of the cells at the edges can be looked up without checking.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+
+		return zero, false
+	}
+
+	return g.cells[p.Y*g.width+p.X], true
+}
+
+// Set sets the value of the cell at p. A point outside of the grid panics.
+func (g *Grid[T]) Set(p Point, value T) {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid: point %v out of the %dx%d grid", p, g.width, g.height))
+	}
+
+	g.cells[p.Y*g.width+p.X] = value
+}
+
+// All returns an iterator over the points and values of the cells of the grid, row by row, from the top left.
+//
+// Example:
+//
+//	for p, r := range garden.All() {
+//	    if r == 'S' {
+//	        start = p
+//	    }
+//	}
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, value := range g.cells {
+			if !yield(Point{X: i % g.width, Y: i / g.width}, value) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iterator over the points of the cells adjacent to p, up, right, down and left, that are
+// in the grid.
+func (g *Grid[T]) Neighbors(p Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, move := range []Point{Up, Right, Down, Left} {
+			if neighbor := p.Add(move); g.In(neighbor) && !yield(neighbor) {
+				return
+			}
+		}
+	}
+}
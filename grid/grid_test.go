@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package grid_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/grid"
+)
+
+func TestParse(t *testing.T) {
+	g := grid.Parse("123\r\n456\r\n", func(r rune) int { return int(r - '0') })
+
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Fatalf("Expected a 3x2 grid, but got %dx%d", g.Width(), g.Height())
+	}
+
+	if value, ok := g.Get(grid.Point{X: 2, Y: 1}); !ok || value != 6 {
+		t.Errorf("Expected 6 at (2, 1), but got %d (in: %t)", value, ok)
+	}
+
+	if value, ok := g.Get(grid.Point{X: 3, Y: 0}); ok || value != 0 {
+		t.Errorf("Expected the zero value outside of the grid, but got %d (in: %t)", value, ok)
+	}
+
+	g.Set(grid.Point{X: 0, Y: 1}, 9)
+
+	var values []int
+	for p, value := range g.All() {
+		if p == (grid.Point{X: 1, Y: 1}) {
+			values = append(values, -value)
+
+			continue
+		}
+
+		values = append(values, value)
+	}
+
+	if expected := []int{1, 2, 3, 9, -5, 6}; !slices.Equal(values, expected) {
+		t.Errorf("Expected %v, but got %v", expected, values)
+	}
+
+	if empty := grid.Parse("", func(rune) int { return 0 }); empty.Width() != 0 || empty.Height() != 0 || len(slices.Collect(empty.Neighbors(grid.Point{}))) != 0 {
+		t.Errorf("Expected an empty grid, but got %dx%d", empty.Width(), empty.Height())
+	}
+}
+
+func TestParsePanics(t *testing.T) {
+	testCases := []struct {
+		name     string
+		f        func()
+		expected string
+	}{
+		{"Ragged", func() { grid.Runes("ab\nabc") }, "grid: line 2 has 3 cells, expected 2"},
+		{"SetOutside", func() { grid.New[int](2, 2).Set(grid.Point{X: 2, Y: 0}, 1) }, "grid: point {2 0} out of the 2x2 grid"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if recovered := recover(); recovered != tc.expected {
+					t.Errorf("Expected a panic with %q, but got %v", tc.expected, recovered)
+				}
+			}()
+
+			tc.f()
+		})
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := grid.Runes("ab\ncd")
+
+	neighbors := slices.Collect(g.Neighbors(grid.Point{X: 0, Y: 0}))
+	if expected := []grid.Point{{X: 1, Y: 0}, {X: 0, Y: 1}}; !slices.Equal(neighbors, expected) {
+		t.Errorf("Expected %v, but got %v", expected, neighbors)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package grid
+
+import "github.com/hvpaiva/goaoc/parse"
+
+// Sparse holds the cells of a map that are not empty, by point, for the maps where most cells are, such as the
+// antennas or the galaxies of a puzzle, and for the maps growing without bounds.
+type Sparse map[Point]rune
+
+// Rect is a rectangle of points, from Min, included, to Max, excluded, like image.Rectangle.
+type Rect struct {
+	Min, Max Point
+}
+
+// In reports whether p is in the rectangle.
+func (r Rect) In(p Point) bool {
+	return p.X >= r.Min.X && p.X < r.Max.X && p.Y >= r.Min.Y && p.Y < r.Max.Y
+}
+
+// ParseSparse returns the cells of the lines of s, split like parse.Lines, that are not the ignore rune, such
+// as '.'. Unlike Parse, the lines may have different lengths.
+//
+// Example:
+//
+//	antennas := grid.ParseSparse(input, '.')
+func ParseSparse(s string, ignore rune) Sparse {
+	cells := Sparse{}
+
+	for y, line := range parse.Lines(s) {
+		x := 0
+		for _, r := range line {
+			if r != ignore {
+				cells[Point{X: x, Y: y}] = r
+			}
+
+			x++
+		}
+	}
+
+	return cells
+}
+
+// Bounds returns the smallest rectangle holding the cells, or the zero Rect when there are none.
+func (s Sparse) Bounds() Rect {
+	var bounds Rect
+
+	first := true
+
+	for p := range s {
+		if first {
+			bounds = Rect{Min: p, Max: p.Add(Point{X: 1, Y: 1})}
+			first = false
+
+			continue
+		}
+
+		bounds.Min.X = min(bounds.Min.X, p.X)
+		bounds.Min.Y = min(bounds.Min.Y, p.Y)
+		bounds.Max.X = max(bounds.Max.X, p.X+1)
+		bounds.Max.Y = max(bounds.Max.Y, p.Y+1)
+	}
+
+	return bounds
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package grid_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/grid"
+)
+
+func TestParseSparse(t *testing.T) {
+	antennas := grid.ParseSparse("......\r\n..0...\n....A\n.0\n", '.')
+
+	expected := grid.Sparse{{X: 2, Y: 1}: '0', {X: 4, Y: 2}: 'A', {X: 1, Y: 3}: '0'}
+	if !reflect.DeepEqual(antennas, expected) {
+		t.Errorf("Expected %v, but got %v", expected, antennas)
+	}
+
+	bounds := antennas.Bounds()
+	if expected := (grid.Rect{Min: grid.Point{X: 1, Y: 1}, Max: grid.Point{X: 5, Y: 4}}); bounds != expected {
+		t.Errorf("Expected the bounds %v, but got %v", expected, bounds)
+	}
+
+	if !bounds.In(grid.Point{X: 4, Y: 3}) || bounds.In(grid.Point{X: 5, Y: 3}) || bounds.In(grid.Point{X: 0, Y: 1}) {
+		t.Errorf("Expected the bounds to hold the points from (1,1) to (4,3)")
+	}
+
+	if bounds := grid.ParseSparse("...", '.').Bounds(); bounds != (grid.Rect{}) {
+		t.Errorf("Expected empty bounds, but got %v", bounds)
+	}
+}
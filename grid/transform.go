@@ -0,0 +1,147 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package grid
+
+import "fmt"
+
+// Transpose returns a copy of the grid with its rows as columns, mirrored along the diagonal from the top left.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	return g.transform(g.height, g.width, func(p Point) Point { return Point{X: p.Y, Y: p.X} })
+}
+
+// RotateRight returns a copy of the grid rotated by 90° clockwise.
+func (g *Grid[T]) RotateRight() *Grid[T] {
+	return g.transform(g.height, g.width, func(p Point) Point { return Point{X: p.Y, Y: g.height - 1 - p.X} })
+}
+
+// RotateLeft returns a copy of the grid rotated by 90° counterclockwise.
+func (g *Grid[T]) RotateLeft() *Grid[T] {
+	return g.transform(g.height, g.width, func(p Point) Point { return Point{X: g.width - 1 - p.Y, Y: p.X} })
+}
+
+// FlipHorizontal returns a copy of the grid mirrored left to right.
+func (g *Grid[T]) FlipHorizontal() *Grid[T] {
+	return g.transform(g.width, g.height, func(p Point) Point { return Point{X: g.width - 1 - p.X, Y: p.Y} })
+}
+
+// FlipVertical returns a copy of the grid mirrored top to bottom.
+func (g *Grid[T]) FlipVertical() *Grid[T] {
+	return g.transform(g.width, g.height, func(p Point) Point { return Point{X: p.X, Y: g.height - 1 - p.Y} })
+}
+
+// Row returns a copy of the cells of row y, from left to right. A row outside of the grid panics.
+func (g *Grid[T]) Row(y int) []T {
+	if y < 0 || y >= g.height {
+		panic(fmt.Sprintf("grid: row %d out of the %dx%d grid", y, g.width, g.height))
+	}
+
+	return append([]T(nil), g.cells[y*g.width:(y+1)*g.width]...)
+}
+
+// Column returns a copy of the cells of column x, from top to bottom. A column outside of the grid panics.
+//
+// Example:
+//
+//	for x := range platform.Width() {
+//	    load += tilt(platform.Column(x))
+//	}
+func (g *Grid[T]) Column(x int) []T {
+	if x < 0 || x >= g.width {
+		panic(fmt.Sprintf("grid: column %d out of the %dx%d grid", x, g.width, g.height))
+	}
+
+	column := make([]T, g.height)
+	for y := range column {
+		column[y] = g.cells[y*g.width+x]
+	}
+
+	return column
+}
+
+// transform returns a grid of the given width and height, with the value of the cell at source(p) of g in each
+// cell p.
+func (g *Grid[T]) transform(width, height int, source func(p Point) Point) *Grid[T] {
+	transformed := New[T](width, height)
+
+	for i := range transformed.cells {
+		from := source(Point{X: i % width, Y: i / width})
+		transformed.cells[i] = g.cells[from.Y*g.width+from.X]
+	}
+
+	return transformed
+}
+
+// Transpose returns the rows of rows as columns, mirrored along the diagonal from the top left, for the puzzles
+// kept as [][]T. Rows of another length than the first one panic, as they are not a rectangle.
+func Transpose[T any](rows [][]T) [][]T {
+	return transformRows(rows, true, func(x, y int) (int, int) { return y, x })
+}
+
+// RotateRight returns rows rotated by 90° clockwise, like Grid.RotateRight.
+func RotateRight[T any](rows [][]T) [][]T {
+	return transformRows(rows, true, func(x, y int) (int, int) { return y, len(rows) - 1 - x })
+}
+
+// RotateLeft returns rows rotated by 90° counterclockwise, like Grid.RotateLeft.
+func RotateLeft[T any](rows [][]T) [][]T {
+	return transformRows(rows, true, func(x, y int) (int, int) { return len(rows[0]) - 1 - y, x })
+}
+
+// FlipHorizontal returns rows mirrored left to right, like Grid.FlipHorizontal.
+func FlipHorizontal[T any](rows [][]T) [][]T {
+	return transformRows(rows, false, func(x, y int) (int, int) { return len(rows[0]) - 1 - x, y })
+}
+
+// FlipVertical returns rows mirrored top to bottom, like Grid.FlipVertical.
+func FlipVertical[T any](rows [][]T) [][]T {
+	return transformRows(rows, false, func(x, y int) (int, int) { return x, len(rows) - 1 - y })
+}
+
+// Column returns a copy of column x of rows, from top to bottom. A row without column x panics.
+func Column[T any](rows [][]T, x int) []T {
+	column := make([]T, len(rows))
+
+	for y, row := range rows {
+		if x < 0 || x >= len(row) {
+			panic(fmt.Sprintf("grid: row %d has no column %d", y+1, x))
+		}
+
+		column[y] = row[x]
+	}
+
+	return column
+}
+
+// transformRows returns the rows of the given rectangle, swapping its width and height when swap is set, with
+// the value of rows at source(x, y) in each cell (x, y). Rows of another length than the first one panic.
+func transformRows[T any](rows [][]T, swap bool, source func(x, y int) (int, int)) [][]T {
+	if len(rows) == 0 {
+		return [][]T{}
+	}
+
+	for y, row := range rows {
+		if len(row) != len(rows[0]) {
+			panic(fmt.Sprintf("grid: row %d has %d cells, expected %d", y+1, len(row), len(rows[0])))
+		}
+	}
+
+	width, height := len(rows[0]), len(rows)
+	if swap {
+		width, height = height, width
+	}
+
+	transformed := make([][]T, height)
+
+	for y := range transformed {
+		transformed[y] = make([]T, width)
+
+		for x := range transformed[y] {
+			fromX, fromY := source(x, y)
+			transformed[y][x] = rows[fromY][fromX]
+		}
+	}
+
+	return transformed
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package grid_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/grid"
+)
+
+// render returns the rows of the grid as lines.
+func render(g *grid.Grid[rune]) string {
+	lines := make([]string, g.Height())
+	for y := range lines {
+		lines[y] = string(g.Row(y))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// runeRows returns the lines of s as rows of runes.
+func runeRows(s string) [][]rune {
+	rows := [][]rune{}
+	for _, line := range strings.Split(s, "\n") {
+		rows = append(rows, []rune(line))
+	}
+
+	return rows
+}
+
+func TestTransforms(t *testing.T) {
+	const input = "abc\ndef"
+
+	testCases := []struct {
+		name     string
+		method   func(*grid.Grid[rune]) *grid.Grid[rune]
+		function func([][]rune) [][]rune
+		expected string
+	}{
+		{"Transpose", (*grid.Grid[rune]).Transpose, grid.Transpose[rune], "ad\nbe\ncf"},
+		{"RotateRight", (*grid.Grid[rune]).RotateRight, grid.RotateRight[rune], "da\neb\nfc"},
+		{"RotateLeft", (*grid.Grid[rune]).RotateLeft, grid.RotateLeft[rune], "cf\nbe\nad"},
+		{"FlipHorizontal", (*grid.Grid[rune]).FlipHorizontal, grid.FlipHorizontal[rune], "cba\nfed"},
+		{"FlipVertical", (*grid.Grid[rune]).FlipVertical, grid.FlipVertical[rune], "def\nabc"},
+	}
+
+	for _, tc := range testCases {
+		original := grid.Runes(input)
+
+		if transformed := render(tc.method(original)); transformed != tc.expected {
+			t.Errorf("Grid.%s: expected %q, but got %q", tc.name, tc.expected, transformed)
+		}
+
+		if render(original) != input {
+			t.Errorf("Grid.%s: expected the grid to be unchanged, but got %q", tc.name, render(original))
+		}
+
+		if transformed := tc.function(runeRows(input)); !reflect.DeepEqual(transformed, runeRows(tc.expected)) {
+			t.Errorf("%s: expected %q, but got %q", tc.name, runeRows(tc.expected), transformed)
+		}
+
+		if transformed := tc.function(nil); len(transformed) != 0 {
+			t.Errorf("%s: expected no rows, but got %q", tc.name, transformed)
+		}
+	}
+}
+
+func TestColumns(t *testing.T) {
+	g := grid.Runes("abc\ndef")
+
+	if column := g.Column(1); !slices.Equal(column, []rune("be")) {
+		t.Errorf("Expected the column be, but got %q", string(column))
+	}
+
+	if column := grid.Column(runeRows("abc\ndef"), 2); !slices.Equal(column, []rune("cf")) {
+		t.Errorf("Expected the column cf, but got %q", string(column))
+	}
+
+	panics := []struct {
+		name     string
+		f        func()
+		expected string
+	}{
+		{"ColumnOutside", func() { g.Column(3) }, "grid: column 3 out of the 3x2 grid"},
+		{"RowOutside", func() { g.Row(-1) }, "grid: row -1 out of the 3x2 grid"},
+		{"ShortRow", func() { grid.Column(runeRows("abc\nde"), 2) }, "grid: row 2 has no column 2"},
+		{"Ragged", func() { grid.Transpose(runeRows("abc\nde")) }, "grid: row 2 has 2 cells, expected 3"},
+	}
+
+	for _, tc := range panics {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if recovered := recover(); recovered != tc.expected {
+					t.Errorf("Expected a panic with %q, but got %v", tc.expected, recovered)
+				}
+			}()
+
+			tc.f()
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// WithInputString creates a RunOption that sets the challenge input to the given string.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputString("1721\n979\n366"))
+func WithInputString(input string) RunOption {
+	return WithInputFunc(func() (string, error) {
+		return input, nil
+	})
+}
+
+// WithInputFile creates a RunOption that reads the challenge input from the file at path.
+// The file is only read after the part to run has been resolved. Trailing line breaks, including
+// Windows "\r\n" ones, are trimmed, as puzzle inputs are saved with a final newline that is not part
+// of the input. A missing file fails with ErrInputFileNotFound.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputFile("input.txt"))
+func WithInputFile(path string) RunOption {
+	return func(options *runOptions) error {
+		options.input = func(context.Context) (string, error) {
+			return fileInput(os.ReadFile(path))
+		}
+		options.inputPath = path
+
+		return nil
+	}
+}
+
+// WithInputFS creates a RunOption that reads the challenge input from the file at path in fsys,
+// e.g. an embed.FS, so the input can be embedded in the solution binary with //go:embed.
+// The file is read and trimmed like with WithInputFile.
+//
+// Example:
+//
+//	//go:embed input.txt
+//	var inputs embed.FS
+//
+//	err := Solve(part1Func, part2Func, WithInputFS(inputs, "input.txt"))
+func WithInputFS(fsys fs.FS, path string) RunOption {
+	return WithInputFunc(func() (string, error) {
+		return fileInput(fs.ReadFile(fsys, path))
+	})
+}
+
+// fileInput turns the content of an input file into the challenge input, trimming its trailing line breaks.
+// A missing file is reported as ErrInputFileNotFound.
+func fileInput(content []byte, err error) (string, error) {
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("%w: %w", ErrInputFileNotFound, err)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return TrimTrailingNewline(string(content)), nil
+}
+
+// WithInputStdin creates a RunOption that reads the challenge input from the stdin of the console manager,
+// e.g. to run `cat input.txt | ./day05 -part=2`. Trailing line breaks are trimmed, like with WithInputFile.
+// Solve reads a piped stdin without this option when no other input is given. Either way, the part is
+// not prompted on a piped stdin, so it must come from the -part flag or the GOAOC_CHALLENGE_PART variable.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputStdin())
+func WithInputStdin() RunOption {
+	return func(options *runOptions) error {
+		options.input = options.readStdin
+		options.inputPath = ""
+
+		return nil
+	}
+}
+
+// readStdin reads the whole stdin of the console manager, or os.Stdin for other InputReaders.
+func (o *runOptions) readStdin(context.Context) (string, error) {
+	stdin := o.stdin()
+
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	content, err := io.ReadAll(stdin)
+	if err != nil {
+		return "", err
+	}
+
+	return TrimTrailingNewline(string(content)), nil
+}
+
+// stdin returns the stdin of the console manager, or os.Stdin for other InputReaders.
+func (o *runOptions) stdin() io.Reader {
+	switch reader := o.reader.(type) {
+	case DefaultConsoleManager:
+		return reader.Env.Stdin
+	case *DefaultConsoleManager:
+		return reader.Env.Stdin
+	default:
+		return os.Stdin
+	}
+}
+
+// WithInputFunc creates a RunOption that takes the challenge input from the given function.
+// The function is called once, only after the part to run has been resolved, so any expensive
+// work it does (network fetch, decompression, ...) is skipped when the run fails earlier, e.g. on
+// an invalid part flag, a failing option or an already cancelled context.
+// An error returned by the function is wrapped in an IOReadError.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputFunc(func() (string, error) {
+//	    return download(2024, 7)
+//	}))
+func WithInputFunc(input func() (string, error)) RunOption {
+	return func(options *runOptions) error {
+		options.input = func(context.Context) (string, error) {
+			return input()
+		}
+		options.inputPath = ""
+
+		return nil
+	}
+}
+
+// InputNormalizer rewrites the raw challenge input before it reaches the challenge. See WithInputNormalization.
+type InputNormalizer func(input string) string
+
+// TrimTrailingNewline is an InputNormalizer that removes the trailing line breaks of the input.
+func TrimTrailingNewline(input string) string {
+	return strings.TrimRight(input, "\r\n")
+}
+
+// CRLFToLF is an InputNormalizer that replaces Windows "\r\n" line breaks with "\n".
+func CRLFToLF(input string) string {
+	return strings.ReplaceAll(input, "\r\n", "\n")
+}
+
+// TrimSpaces is an InputNormalizer that removes the leading and trailing white space of the input.
+func TrimSpaces(input string) string {
+	return strings.TrimSpace(input)
+}
+
+// WithInputNormalization creates a RunOption that applies the given normalizers, in order, to the input
+// before it reaches the challenge, whatever its source. It avoids off-by-one answers caused by "\r"
+// characters or trailing newlines, e.g. in inputs saved on Windows.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithInputNormalization(CRLFToLF, TrimTrailingNewline))
+func WithInputNormalization(normalizers ...InputNormalizer) RunOption {
+	return func(options *runOptions) error {
+		options.normalizers = append(options.normalizers, normalizers...)
+
+		return nil
+	}
+}
+
+// WithInputTransform creates a RunOption that applies the given transforms, in order, to the input before
+// it reaches the challenge, so preprocessing shared by both parts, such as splitting off a header line,
+// lives in one place. Transforms and normalizers run in the order their options are given.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithInputTransform(strings.ToUpper, dropHeader))
+func WithInputTransform(transforms ...func(input string) string) RunOption {
+	return func(options *runOptions) error {
+		for _, transform := range transforms {
+			options.normalizers = append(options.normalizers, transform)
+		}
+
+		return nil
+	}
+}
+
+// normalize applies the normalizers and transforms set with WithInputNormalization and WithInputTransform to input.
+func (o runOptions) normalize(input string) string {
+	for _, normalizer := range o.normalizers {
+		input = normalizer(input)
+	}
+
+	return input
+}
+
+// withInput prepends an input option for the given input to options, so input options
+// provided by the caller take precedence over it.
+func withInput(input string, options []RunOption) []RunOption {
+	return append([]RunOption{WithInputString(input)}, options...)
+}
+
+// loadInput calls the configured input source and normalizes its input. In sample mode, the sample input is
+// loaded instead. Without an input source, a piped stdin is read. It returns ErrMissingInput if there is none.
+// The input of RunStream, read by the challenges, is empty.
+func (o runOptions) loadInput(ctx context.Context) (string, error) {
+	if o.stream {
+		return "", nil
+	}
+
+	if o.sample {
+		o.input = o.loadSample
+	}
+
+	if o.input == nil && isPiped(o.stdin()) {
+		o.input = o.readStdin
+	}
+
+	if o.input == nil {
+		return "", IOReadError{Err: ErrMissingInput}
+	}
+
+	return o.read(ctx, o.input)
+}
+
+// read calls the input source with ctx and normalizes its input. Errors are wrapped in an IOReadError.
+func (o runOptions) read(ctx context.Context, source func(ctx context.Context) (string, error)) (string, error) {
+	input, err := source(ctx)
+	if err != nil {
+		var readErr IOReadError
+		if errors.As(err, &readErr) {
+			return "", err
+		}
+
+		return "", IOReadError{Err: err}
+	}
+
+	return o.normalize(input), nil
+}
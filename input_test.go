@@ -0,0 +1,310 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/hvpaiva/goaoc"
+	"github.com/hvpaiva/goaoc/mock"
+)
+
+func TestSolveWithInputOptions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte("from file\r\n\n"), 0o600); err != nil {
+		t.Fatalf("Unexpected error writing input file: %v", err)
+	}
+
+	testCases := []struct {
+		name           string
+		option         goaoc.RunOption
+		expectedOutput string
+	}{
+		{"String", goaoc.WithInputString("from string"), "The challenge result is from string\n"},
+		{"File", goaoc.WithInputFile(path), "The challenge result is from file\n"},
+		{"FS", goaoc.WithInputFS(fstest.MapFS{"inputs/day07.txt": {Data: []byte("from fs\n")}}, "inputs/day07.txt"), "The challenge result is from fs\n"},
+		{"Func", goaoc.WithInputFunc(func() (string, error) { return "from func", nil }), "The challenge result is from func\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager("1", nil, nil)
+			err := goaoc.Solve(echoPart, echoPart, goaoc.WithManager(&mok), tc.option)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if output := mok.GetStdout(); output != tc.expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", tc.expectedOutput, output)
+			}
+		})
+	}
+}
+
+func TestSolveInputErrors(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+
+	testCases := []struct {
+		name      string
+		options   []goaoc.RunOption
+		expectErr string
+	}{
+		{"MissingInput", nil, "failed to read input: no input specified, please provide the challenge input"},
+		{"FuncFails", []goaoc.RunOption{goaoc.WithInputFunc(func() (string, error) { return "", errFetch })}, "failed to read input: fetch failed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager("1", nil, nil)
+			options := append([]goaoc.RunOption{goaoc.WithManager(&mok)}, tc.options...)
+			err := goaoc.Solve(echoPart, echoPart, options...)
+
+			var readErr goaoc.IOReadError
+			if err == nil || err.Error() != tc.expectErr || !errors.As(err, &readErr) {
+				t.Fatalf("Expected IOReadError '%s', but got: %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func pipe(t *testing.T, content string) *os.File {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Unexpected error creating pipe: %v", err)
+	}
+
+	t.Cleanup(func() { _ = r.Close() })
+
+	if _, err := w.WriteString(content); err != nil {
+		t.Fatalf("Unexpected error writing to pipe: %v", err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("Unexpected error closing pipe: %v", err)
+	}
+
+	return r
+}
+
+func TestSolveWithPipedStdin(t *testing.T) {
+	noClipboard := goaoc.WithEnvLookup(func(key string) string {
+		if key == "GOAOC_DISABLE_COPY_CLIPBOARD" {
+			return "true"
+		}
+
+		return ""
+	})
+
+	testCases := []struct {
+		name    string
+		options []goaoc.RunOption
+	}{
+		{"Detected", nil},
+		{"WithInputStdin", []goaoc.RunOption{goaoc.WithInputStdin()}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+
+			options := append([]goaoc.RunOption{
+				goaoc.WithArgs([]string{"-part=2"}),
+				goaoc.WithStdin(pipe(t, "from stdin\n")),
+				goaoc.WithStdout(&stdout),
+				noClipboard,
+			}, tc.options...)
+
+			if err := goaoc.Solve(echoPart, echoPart, options...); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if expected := "The challenge result is from stdin\n"; stdout.String() != expected {
+				t.Errorf("Expected output '%s', but got '%s'", expected, stdout.String())
+			}
+		})
+	}
+
+	t.Run("PartNotPrompted", func(t *testing.T) {
+		var stdout bytes.Buffer
+
+		err := goaoc.Solve(echoPart, echoPart,
+			goaoc.WithArgs([]string{}),
+			goaoc.WithStdin(pipe(t, "1\n")),
+			goaoc.WithStdout(&stdout),
+			noClipboard)
+
+		if !errors.Is(err, goaoc.ErrMissingPart) || stdout.Len() != 0 {
+			t.Errorf("Expected ErrMissingPart without a prompt, but got %v and output '%s'", err, stdout.String())
+		}
+	})
+}
+
+func TestRunWithInputNormalization(t *testing.T) {
+	testCases := []struct {
+		name        string
+		normalizers []goaoc.InputNormalizer
+		expected    string
+	}{
+		{"None", nil, "  a\r\nb\r\n"},
+		{"CRLFToLF", []goaoc.InputNormalizer{goaoc.CRLFToLF}, "  a\nb\n"},
+		{"TrimTrailingNewline", []goaoc.InputNormalizer{goaoc.TrimTrailingNewline}, "  a\r\nb"},
+		{"TrimSpaces", []goaoc.InputNormalizer{goaoc.TrimSpaces}, "a\r\nb"},
+		{"Chained", []goaoc.InputNormalizer{goaoc.CRLFToLF, goaoc.TrimSpaces}, "a\nb"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var received string
+
+			mok := mock.NewManager("1", nil, nil)
+			err := goaoc.Run("  a\r\nb\r\n", func(input string) int { received = input; return 0 }, nil,
+				goaoc.WithManager(&mok), goaoc.WithInputNormalization(tc.normalizers...))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if received != tc.expected {
+				t.Errorf("Expected input %q, but got %q", tc.expected, received)
+			}
+		})
+	}
+}
+
+func TestRunWithInputTransform(t *testing.T) {
+	var received string
+
+	dropHeader := func(input string) string {
+		_, body, _ := strings.Cut(input, "\n")
+
+		return body
+	}
+
+	mok := mock.NewManager("1", nil, nil)
+	err := goaoc.Run("header\r\nbody\r\n", func(input string) int { received = input; return 0 }, nil,
+		goaoc.WithManager(&mok),
+		goaoc.WithInputNormalization(goaoc.CRLFToLF),
+		goaoc.WithInputTransform(dropHeader, strings.ToUpper),
+		goaoc.WithInputNormalization(goaoc.TrimTrailingNewline))
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if received != "BODY" {
+		t.Errorf("Expected input %q, but got %q", "BODY", received)
+	}
+}
+
+func TestSolveWithMissingInputFS(t *testing.T) {
+	mok := mock.NewManager("1", nil, nil)
+	err := goaoc.Solve(echoPart, echoPart, goaoc.WithManager(&mok), goaoc.WithInputFS(fstest.MapFS{}, "input.txt"))
+
+	if !errors.Is(err, goaoc.ErrInputFileNotFound) || !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Expected ErrInputFileNotFound, but got: %v", err)
+	}
+}
+
+func TestSolveWithMissingInputFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+
+	mok := mock.NewManager("1", nil, nil)
+	err := goaoc.Solve(echoPart, echoPart, goaoc.WithManager(&mok), goaoc.WithInputFile(path))
+
+	if !errors.Is(err, goaoc.ErrInputFileNotFound) || !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Expected ErrInputFileNotFound, but got: %v", err)
+	}
+
+	expectErr := "failed to read input: input file not found, please download the puzzle input: open " + path + ": no such file or directory"
+	if err.Error() != expectErr {
+		t.Errorf("Expected error '%s', but got '%s'", expectErr, err)
+	}
+}
+
+func TestInputIsLoadedAfterPartResolution(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name    string
+		ctx     context.Context
+		options []goaoc.RunOption
+	}{
+		{"InvalidPart", context.Background(), []goaoc.RunOption{goaoc.WithEnvLookup(func(_ string) string { return "3" })}},
+		{"FlagParsingFails", context.Background(), []goaoc.RunOption{goaoc.WithArgs([]string{"-unknown"})}},
+		{"FailingOption", context.Background(), []goaoc.RunOption{goaoc.WithPart(0)}},
+		{"CancelledContext", cancelled, []goaoc.RunOption{goaoc.WithPart(1)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			options := append([]goaoc.RunOption{
+				goaoc.WithArgs([]string{}),
+				goaoc.WithStdout(new(bytes.Buffer)),
+				goaoc.WithInputFunc(func() (string, error) {
+					calls++
+
+					return "input", nil
+				}),
+			}, tc.options...)
+
+			err := goaoc.RunContext(tc.ctx, "", echoPart, echoPart, options...)
+			if err == nil {
+				t.Fatal("Expected an error, but got nil")
+			}
+
+			if calls != 0 {
+				t.Errorf("Expected the input not to be loaded, but it was loaded %d times", calls)
+			}
+		})
+	}
+
+	t.Run("LoadedOnceForBothParts", func(t *testing.T) {
+		calls := 0
+		mok := mock.NewManager("both", nil, nil)
+		err := goaoc.Solve(echoPart, echoPart, goaoc.WithManager(&mok), goaoc.WithInputFunc(func() (string, error) {
+			calls++
+
+			return "input", nil
+		}))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("Expected the input to be loaded once, but it was loaded %d times", calls)
+		}
+	})
+}
+
+func TestRunInputOptionTakesPrecedence(t *testing.T) {
+	mok := mock.NewManager("1", nil, nil)
+	err := goaoc.Run("argument", echoPart, echoPart, goaoc.WithManager(&mok), goaoc.WithInputString("option"))
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if output := mok.GetStdout(); output != "The challenge result is option\n" {
+		t.Errorf("Expected the input option to be used, but got '%s'", output)
+	}
+}
+
+func echoPart(input string) string {
+	return input
+}
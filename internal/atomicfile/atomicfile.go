@@ -0,0 +1,41 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+// Package atomicfile writes files atomically, for the caches shared by concurrent goaoc processes.
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Write writes data to the file at path, with the given permissions, through a temporary file renamed over it,
+// so readers see either the previous content or the new one, never a partial write.
+func Write(path string, data []byte, perm os.FileMode) error {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	// Once renamed, the temporary file no longer exists and the removal fails harmlessly.
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	if err := file.Chmod(perm); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+// Package buildinfo looks up the version of goaoc in the build information of the binary using it.
+package buildinfo
+
+import "runtime/debug"
+
+// ModulePath is the import path of the goaoc module.
+const ModulePath = "github.com/hvpaiva/goaoc"
+
+// Version returns the version of the goaoc module in the build information, or "(devel)" when unknown.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+
+	if info.Main.Path == ModulePath {
+		return info.Main.Version
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == ModulePath {
+			return dep.Version
+		}
+	}
+
+	return "(devel)"
+}
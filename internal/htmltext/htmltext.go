@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+// Package htmltext extracts the text of the HTML pages of adventofcode.com.
+package htmltext
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// article matches the articles of a page of adventofcode.com, which hold the description of each part of a
+	// puzzle, or the message of a response.
+	article = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+
+	// tag matches an HTML tag.
+	tag = regexp.MustCompile(`<[^>]*>`)
+
+	// spaces matches runs of white space.
+	spaces = regexp.MustCompile(`\s+`)
+)
+
+// Articles returns the HTML of the articles of page, in order.
+func Articles(page string) []string {
+	matches := article.FindAllStringSubmatch(page, -1)
+	articles := make([]string, 0, len(matches))
+
+	for _, match := range matches {
+		articles = append(articles, match[1])
+	}
+
+	return articles
+}
+
+// Message returns the text of the first article of page, such as the response to an answer, without markup and
+// with its white space collapsed. A page without article is returned whole, the same way.
+func Message(page string) string {
+	if match := article.FindStringSubmatch(page); match != nil {
+		page = match[1]
+	}
+
+	return strings.TrimSpace(Collapse(Text(page)))
+}
+
+// Text returns fragment without its tags, and with its HTML entities unescaped.
+func Text(fragment string) string {
+	return html.UnescapeString(tag.ReplaceAllString(fragment, ""))
+}
+
+// Collapse returns s with its runs of white space replaced by a single space.
+func Collapse(s string) string {
+	return spaces.ReplaceAllString(s, " ")
+}
@@ -10,6 +10,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/tiagomelo/go-clipboard/clipboard"
 )
@@ -28,8 +31,26 @@ type Env struct {
 	// Args holds command-line arguments, minus the program name.
 	// This slice allows the passing and manipulation of additional parameters through the command line.
 	Args []string
+
+	// EnvLookup retrieves the value of the environment variable named by the key, like os.Getenv.
+	// When nil, os.Getenv is used. Set it to inject the environment, e.g. in parallel tests.
+	EnvLookup func(key string) string
+}
+
+// getenv retrieves the value of the environment variable named by the key using EnvLookup,
+// falling back to os.Getenv.
+func (e Env) getenv(key string) string {
+	if e.EnvLookup != nil {
+		return e.EnvLookup(key)
+	}
+
+	return os.Getenv(key)
 }
 
+// consoleMu serializes the console access of all DefaultConsoleManagers, so concurrent runs sharing
+// the same streams do not interleave prompts and answers, nor race on a shared writer.
+var consoleMu sync.Mutex
+
 var defaultConsoleEnv = Env{
 	Stdin:  os.Stdin,
 	Stdout: os.Stdout,
@@ -39,6 +60,10 @@ var defaultConsoleEnv = Env{
 // DefaultConsoleManager manages I/O via the default console, implementing IOManager.
 type DefaultConsoleManager struct {
 	Env Env
+
+	// Resolver resolves the part to run. When nil, DefaultPartResolver is used,
+	// which checks the flag, the environment and then prompts on stdin.
+	Resolver PartResolver
 }
 
 // NewConsoleManager initializes a new DefaultConsoleManager with standard console streams.
@@ -48,48 +73,183 @@ func NewConsoleManager() DefaultConsoleManager {
 	}
 }
 
-// Read derives arguments like 'part' from various sources (flags, environment, or stdin).
-// It returns errors if flag parsing fails or stdin input cannot be retrieved.
+// WithArgs creates a RunOption that sets the command-line arguments inspected by the DefaultConsoleManager,
+// instead of os.Args. Use it when the program defines its own flags, to control exactly which arguments
+// goaoc parses. It applies to the default manager and to a DefaultConsoleManager given with WithManager,
+// WithReader or WithWriter.
+//
+// Example:
+//
+//	flag.Parse()
+//	err := Run(inputData, part1Func, part2Func, WithArgs(flag.Args()))
+func WithArgs(args []string) RunOption {
+	return func(options *runOptions) error {
+		options.console = append(options.console, func(manager *DefaultConsoleManager) {
+			manager.Env.Args = args
+		})
+
+		return nil
+	}
+}
+
+// WithStdout creates a RunOption that redirects the output of the DefaultConsoleManager to w,
+// without building a custom IOManager. It applies to the default manager and to a DefaultConsoleManager
+// given with WithManager, WithReader or WithWriter.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	err := Run(inputData, part1Func, part2Func, WithStdout(&buf))
+func WithStdout(w io.Writer) RunOption {
+	return func(options *runOptions) error {
+		options.console = append(options.console, func(manager *DefaultConsoleManager) {
+			manager.Env.Stdout = w
+		})
+
+		return nil
+	}
+}
+
+// WithStdin creates a RunOption that makes the DefaultConsoleManager read interactive answers,
+// such as the part prompt, from r instead of os.Stdin. It applies to the default manager and to a
+// DefaultConsoleManager given with WithManager, WithReader or WithWriter.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithStdin(strings.NewReader("2\n")))
+func WithStdin(r io.Reader) RunOption {
+	return func(options *runOptions) error {
+		options.console = append(options.console, func(manager *DefaultConsoleManager) {
+			manager.Env.Stdin = r
+		})
+
+		return nil
+	}
+}
+
+// WithEnvLookup creates a RunOption that sets the function used by the DefaultConsoleManager to read
+// environment variables, such as GOAOC_CHALLENGE_PART and GOAOC_DISABLE_COPY_CLIPBOARD, instead of os.Getenv.
+// It applies to the default manager and to a DefaultConsoleManager given with WithManager, WithReader or WithWriter.
+//
+// Example:
+//
+//	env := map[string]string{"GOAOC_CHALLENGE_PART": "2", "GOAOC_DISABLE_COPY_CLIPBOARD": "true"}
+//	err := Run(inputData, part1Func, part2Func, WithEnvLookup(func(key string) string { return env[key] }))
+func WithEnvLookup(lookup func(key string) string) RunOption {
+	return func(options *runOptions) error {
+		options.console = append(options.console, func(manager *DefaultConsoleManager) {
+			manager.Env.EnvLookup = lookup
+		})
+
+		return nil
+	}
+}
+
+// WithPartResolver creates a RunOption that sets the PartResolver used by the DefaultConsoleManager,
+// e.g. to drop the interactive prompt in CI or to read the part from a config file.
+// It applies to the default manager and to a DefaultConsoleManager given with WithManager, WithReader or WithWriter.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithPartResolver(ChainPartResolvers(EnvPartResolver(), FlagPartResolver())))
+func WithPartResolver(resolver PartResolver) RunOption {
+	return func(options *runOptions) error {
+		options.console = append(options.console, func(manager *DefaultConsoleManager) {
+			manager.Resolver = resolver
+		})
+
+		return nil
+	}
+}
+
+// configureConsole applies the console settings to a copy of manager when it is a DefaultConsoleManager,
+// returning the configured copy and true. It returns false for other managers, or when there are no settings,
+// so the caller keeps them untouched. A pointer manager is copied, so the caller's value is not modified.
+func configureConsole(manager any, settings []func(manager *DefaultConsoleManager)) (DefaultConsoleManager, bool) {
+	var console DefaultConsoleManager
+
+	if len(settings) == 0 {
+		return console, false
+	}
+
+	switch m := manager.(type) {
+	case DefaultConsoleManager:
+		console = m
+	case *DefaultConsoleManager:
+		console = *m
+	default:
+		return console, false
+	}
+
+	for _, setting := range settings {
+		setting(&console)
+	}
+
+	return console, true
+}
+
+// Read derives arguments like 'part' from various sources (flags, environment, or stdin), as defined by
+// the manager's Resolver. Flags are parsed with a new flag set on every call, and console access is
+// serialized between managers, so concurrent runs do not interfere with each other.
+// It returns an error if flag parsing fails or stdin input cannot be retrieved, and ErrMissingPart if no
+// source provides the part.
 func (m DefaultConsoleManager) Read(arg string) (part string, err error) {
 	if arg != "part" {
 		return "", nil
 	}
 
-	checks := []func() (string, error){
-		func() (string, error) { return getPartInFlag(m.Env) },
-		getPartInEnv,
-		func() (string, error) { return getPartInStdin(m.Env) },
+	resolver := m.Resolver
+	if resolver == nil {
+		resolver = DefaultPartResolver()
 	}
 
-	for _, check := range checks {
-		part, err = check()
-		if err != nil {
-			return "", err
-		}
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
 
-		if part != "" {
-			return part, nil
-		}
+	part, err = resolver.ResolvePart(m.Env)
+	if err != nil {
+		return "", err
 	}
 
-	return part, IOReadError{Err: ErrMissingPart}
+	if part == "" {
+		return "", IOReadError{Err: ErrMissingPart}
+	}
+
+	return part, nil
 }
 
 // Write outputs the result to console and optionally copies to clipboard if not disabled by GOAOC_DISABLE_COPY_CLIPBOARD.
+// Multi-line results are printed below the header line, so their layout is preserved.
 // Errors can arise from console output failures or clipboard command errors.
 func (m DefaultConsoleManager) Write(result string) error {
-	if _, err := fmt.Fprintf(m.Env.Stdout, "The challenge result is %s\n", result); err != nil {
+	format := "The challenge result is %s\n"
+	if strings.Contains(result, "\n") {
+		format = "The challenge result is:\n%s\n"
+	}
+
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if _, err := fmt.Fprintf(m.Env.Stdout, format, result); err != nil {
 		return IOWriteError{Err: err}
 	}
 
-	toClipboard(result, m.Env.Stdout)
+	toClipboard(result, m.Env)
 
 	return nil
 }
 
-// getPartInFlag attempts to parse the 'part' option from command-line flags.
-// It supports standard flags only and returns errors if parsing fails.
-func getPartInFlag(env Env) (part string, err error) {
+// consoleFlags holds the command-line flags understood by the DefaultConsoleManager.
+type consoleFlags struct {
+	part     string
+	sample   bool
+	profile  string
+	describe bool
+	verify   bool
+}
+
+// parseFlags parses the command-line flags of env. It supports standard flags only and returns errors if parsing fails.
+func parseFlags(env Env) (flags consoleFlags, err error) {
 	fs := flag.NewFlagSet("goaoc", flag.ContinueOnError)
 	fs.SetOutput(env.Stdout)
 
@@ -99,28 +259,119 @@ func getPartInFlag(env Env) (part string, err error) {
 		fs.PrintDefaults()
 	}
 
-	fs.StringVar(&part, "part", "", "Part of the challenge, valid values are (1/2)")
+	fs.StringVar(&flags.part, "part", "", "Part of the challenge, valid values are (1/2/both)")
+	fs.BoolVar(&flags.sample, "sample", false, "Run against the sample input instead of the puzzle input")
+	fs.StringVar(&flags.profile, "profile", "", "Profile of the adventofcode.com account used to download inputs")
+	fs.BoolVar(&flags.describe, "puzzle", false, "Print the description of the puzzle instead of running it")
+	fs.BoolVar(&flags.verify, "verify", false, "Compare the answers with the verified answers of the puzzle")
 
 	if err = fs.Parse(env.Args); err != nil {
-		return "", IOReadError{Err: err}
+		return consoleFlags{}, IOReadError{Err: err}
 	}
 
-	return part, nil
+	return flags, nil
+}
+
+// getPartInFlag attempts to parse the 'part' option from command-line flags.
+// It supports standard flags only and returns errors if parsing fails.
+func getPartInFlag(env Env) (string, error) {
+	flags, err := parseFlags(env)
+
+	return flags.part, err
+}
+
+// getSampleInFlag reports whether the -sample flag is set in the command-line flags. Unlike the part, the
+// arguments are scanned leniently, ignoring unknown flags, as the sample flag is checked even when the part
+// is set with WithPart by a program with its own flags.
+func getSampleInFlag(env Env) (bool, error) {
+	return getBoolFlag(env, "sample")
+}
+
+// getDescribeInFlag reports whether the -puzzle flag is set in the command-line flags, scanned leniently like
+// the sample flag.
+func getDescribeInFlag(env Env) (bool, error) {
+	return getBoolFlag(env, "puzzle")
+}
+
+// getVerifyInFlag reports whether the -verify flag is set in the command-line flags, scanned leniently like
+// the sample flag.
+func getVerifyInFlag(env Env) (bool, error) {
+	return getBoolFlag(env, "verify")
+}
+
+// getBoolFlag reports whether the boolean flag with the given name is set in the command-line flags, as -name
+// or -name=value, ignoring unknown flags.
+func getBoolFlag(env Env, flagName string) (bool, error) {
+	for _, arg := range env.Args {
+		if arg == "--" {
+			break
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if name != flagName || !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		if !hasValue {
+			return true, nil
+		}
+
+		sample, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, IOReadError{Err: err}
+		}
+
+		return sample, nil
+	}
+
+	return false, nil
+}
+
+// getProfileInFlag returns the value of the -profile flag, as -profile=name or -profile name, in the
+// command-line flags, or an empty string if it is not set. The arguments are scanned leniently, like the
+// sample flag.
+func getProfileInFlag(env Env) (string, error) {
+	for i, arg := range env.Args {
+		if arg == "--" {
+			break
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if name != "profile" || !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		if hasValue {
+			return value, nil
+		}
+
+		if i+1 == len(env.Args) {
+			return "", IOReadError{Err: fmt.Errorf("%w: -profile", ErrMissingFlagValue)}
+		}
+
+		return env.Args[i+1], nil
+	}
+
+	return "", nil
 }
 
 // getPartInEnv retrieves the 'part' from environment variables returned as a simple string.
-func getPartInEnv() (string, error) {
-	part := os.Getenv("GOAOC_CHALLENGE_PART")
+func getPartInEnv(env Env) (string, error) {
+	part := env.getenv("GOAOC_CHALLENGE_PART")
 
 	return part, nil
 }
 
 // getPartInStdin queries stdin to get which part the user wishes to run. Useful in interactive console mode.
-// Returns errors for invalid or empty inputs.
+// Returns errors for invalid or empty inputs. A piped stdin holds the puzzle input, so it is never prompted.
 func getPartInStdin(env Env) (string, error) {
 	var part string
 
-	_, err := fmt.Fprintln(env.Stdout, "Which part do you want to run? (1/2)")
+	if isPiped(env.Stdin) {
+		return "", IOReadError{Err: fmt.Errorf("%w: stdin is piped, use the -part flag or GOAOC_CHALLENGE_PART", ErrMissingPart)}
+	}
+
+	_, err := fmt.Fprintln(env.Stdout, "Which part do you want to run? (1/2/both)")
 	if err != nil {
 		return "", err
 	}
@@ -133,20 +384,36 @@ func getPartInStdin(env Env) (string, error) {
 	return part, nil
 }
 
+// isPiped reports whether r is a file that is not a terminal, such as a pipe or a redirected file,
+// in which case it holds data, e.g. the puzzle input, rather than the answers of an interactive user.
+func isPiped(r io.Reader) bool {
+	file, ok := r.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice == 0
+}
+
 // toClipboard tries to copy the given value to the system clipboard. Skips copying if the environment is set to not copy.
 // Errors while executing the clipboard command are printed but do not stop the program.
-func toClipboard(value string, stdout io.Writer) {
-	envVar := os.Getenv("GOAOC_DISABLE_COPY_CLIPBOARD")
+func toClipboard(value string, env Env) {
+	envVar := env.getenv("GOAOC_DISABLE_COPY_CLIPBOARD")
 	if envVar == "true" {
 		return
 	}
 
 	c := clipboard.New()
 	if err := c.CopyText(value); err != nil {
-		_, _ = fmt.Fprintf(stdout, "Error copying to clipboard: %s\n", err)
+		_, _ = fmt.Fprintf(env.Stdout, "Error copying to clipboard: %s\n", err)
 
 		return
 	}
 
-	_, _ = fmt.Fprintf(stdout, "Copied to clipboard: %s\n", value)
+	_, _ = fmt.Fprintf(env.Stdout, "Copied to clipboard: %s\n", value)
 }
@@ -99,7 +99,7 @@ func TestToClipboard(t *testing.T) {
 				_ = os.Setenv("GOAOC_DISABLE_COPY_CLIPBOARD", "true")
 			}
 
-			toClipboard("test value", env.Stdout)
+			toClipboard("test value", env)
 
 			output := manager.Env.Stdout.(*bytes.Buffer).String()
 			if !strings.Contains(output, tc.output) {
@@ -126,6 +126,23 @@ func TestOutput(t *testing.T) {
 	}
 }
 
+func TestOutputMultiLine(t *testing.T) {
+	env := mockEnv([]string{}, "", new(bytes.Buffer))
+	manager := DefaultConsoleManager{Env: env}
+	_ = os.Setenv("GOAOC_DISABLE_COPY_CLIPBOARD", "true")
+
+	err := manager.Write("#..#\n####")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := env.Stdout.(*bytes.Buffer).String()
+	expectedOutput := "The challenge result is:\n#..#\n####\n"
+	if output != expectedOutput {
+		t.Errorf("Expected output '%s', but got '%s'", expectedOutput, output)
+	}
+}
+
 func TestSelectPartErrors(t *testing.T) {
 	_ = os.Unsetenv("GOAOC_CHALLENGE_PART")
 
@@ -159,3 +176,103 @@ func TestNewConsoleManager(t *testing.T) {
 		t.Errorf("expected Stdin to be %v, but got %v", os.Stdin, manager.Env.Stdin)
 	}
 }
+
+func TestWithArgs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		manager IOManager
+	}{
+		{"DefaultManager", nil},
+		{"ConsoleManager", DefaultConsoleManager{Env: mockEnv([]string{"-part=2"}, "", new(bytes.Buffer))}},
+		{"ConsoleManagerPointer", &DefaultConsoleManager{Env: mockEnv([]string{"-part=2"}, "", new(bytes.Buffer))}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := runOptions{reader: tc.manager, writer: tc.manager}
+
+			err := injectOptions(&opts, 2, WithArgs([]string{"-part=1"}))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if opts.part != 1 {
+				t.Errorf("Expected part 1 from the given args, but got %d", opts.part)
+			}
+
+			if pointer, ok := tc.manager.(*DefaultConsoleManager); ok && pointer.Env.Args[0] != "-part=2" {
+				t.Errorf("Expected the given manager not to be modified, but got args %v", pointer.Env.Args)
+			}
+		})
+	}
+}
+
+func TestWithArgsIgnoresCustomManagers(t *testing.T) {
+	manager := &readerStub{part: "2"}
+	opts := runOptions{reader: manager}
+
+	err := injectOptions(&opts, 2, WithArgs([]string{"-part=1"}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if opts.reader != manager || opts.part != 2 {
+		t.Errorf("Expected the custom manager to be kept, but got %#v with part %d", opts.reader, opts.part)
+	}
+}
+
+type readerStub struct {
+	part string
+}
+
+func (r *readerStub) Read(_ string) (string, error) {
+	return r.part, nil
+}
+
+func (r *readerStub) Write(_ string) error {
+	return nil
+}
+
+func TestEnvLookup(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]string{"GOAOC_CHALLENGE_PART": "2", "GOAOC_DISABLE_COPY_CLIPBOARD": "true"}
+	env := mockEnv([]string{}, "", new(bytes.Buffer))
+	env.EnvLookup = func(key string) string { return vars[key] }
+	manager := DefaultConsoleManager{Env: env}
+
+	part, err := manager.Read("part")
+	if err != nil || part != "2" {
+		t.Fatalf("Expected part 2 from the injected environment, but got %q (err: %v)", part, err)
+	}
+
+	if err := manager.Write("42"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := env.Stdout.(*bytes.Buffer).String()
+	if output != "The challenge result is 42\n" {
+		t.Errorf("Expected clipboard to be disabled by the injected environment, but got '%s'", output)
+	}
+}
+
+func TestWithEnvLookup(t *testing.T) {
+	t.Parallel()
+
+	opts := runOptions{reader: DefaultConsoleManager{Env: mockEnv([]string{}, "", new(bytes.Buffer))}}
+
+	err := injectOptions(&opts, 2, WithEnvLookup(func(key string) string {
+		if key == "GOAOC_CHALLENGE_PART" {
+			return "2"
+		}
+
+		return ""
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if opts.part != 2 {
+		t.Errorf("Expected part 2 from the injected environment, but got %d", opts.part)
+	}
+}
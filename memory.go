@@ -0,0 +1,76 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"runtime/metrics"
+	"time"
+)
+
+// memoryPollInterval is how often the heap usage is sampled while a memory limit is set.
+const memoryPollInterval = 10 * time.Millisecond
+
+// heapMetric is the runtime metric of the bytes occupied by the objects of the heap, like the HeapAlloc of
+// runtime.MemStats, but read without stopping the world.
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// WithMemoryLimit creates a RunOption that aborts a part when the heap allocated by the program
+// exceeds limit bytes while the part is running, returning a MemoryLimitError. It protects the machine
+// from runaway solutions, such as a BFS that never prunes its queue.
+//
+// The heap usage is sampled every 10ms from runtime/metrics, which does not stop the world, and accounts for the
+// whole process, not only the challenge. As with WithTimeout, the aborted challenge cannot be stopped: it keeps
+// running, and allocating, in its goroutine, so the program should exit once Run returns the error. To also have
+// the garbage collector work harder as the heap grows, set a soft limit for the whole process with GOMEMLIMIT or
+// debug.SetMemoryLimit.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithMemoryLimit(4<<30)) // 4 GiB
+func WithMemoryLimit(limit uint64) RunOption {
+	return func(options *runOptions) error {
+		options.memoryLimit = limit
+
+		return nil
+	}
+}
+
+// watchMemory returns a context cancelled with a MemoryLimitError as soon as the heap allocated
+// exceeds limit, sampling it every memoryPollInterval until the returned stop function is called.
+func watchMemory(ctx context.Context, part Part, limit uint64) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(memoryPollInterval)
+		defer ticker.Stop()
+
+		heap := []metrics.Sample{{Name: heapMetric}}
+
+		for {
+			metrics.Read(heap)
+
+			if usage := heap[0].Value.Uint64(); usage > limit {
+				cancel(MemoryLimitError{Part: part, Limit: limit, Usage: usage})
+
+				return
+			}
+
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return ctx, func() {
+		close(done)
+		cancel(nil)
+	}
+}
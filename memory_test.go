@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/goaoc"
+	"github.com/hvpaiva/goaoc/mock"
+)
+
+func TestRunWithMemoryLimit(t *testing.T) {
+	var stats runtime.MemStats
+
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	limit := stats.HeapAlloc + 32<<20
+
+	t.Run("Exceeded", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		hog := func(_ string) int {
+			var chunks [][]byte
+
+			for {
+				select {
+				case <-release:
+					return len(chunks)
+				case <-time.After(time.Millisecond):
+				}
+
+				if len(chunks) < 128 {
+					chunks = append(chunks, make([]byte, 1<<20))
+				}
+			}
+		}
+
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", hog, mockPartTwo, goaoc.WithManager(&mok), goaoc.WithMemoryLimit(limit), goaoc.WithTimeout(10*time.Second))
+
+		var memoryErr goaoc.MemoryLimitError
+		if !errors.As(err, &memoryErr) || memoryErr.Part != 1 || memoryErr.Limit != limit || memoryErr.Usage <= limit {
+			t.Fatalf("Expected MemoryLimitError for part 1, but got: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "" {
+			t.Errorf("Expected no output, but got '%s'", output)
+		}
+	})
+
+	t.Run("WithinLimit", func(t *testing.T) {
+		mok := mock.NewManager("2", nil, nil)
+		err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok), goaoc.WithMemoryLimit(1<<40))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is 24\n" {
+			t.Errorf("Expected output 'The challenge result is 24\n', but got '%s'", output)
+		}
+	})
+}
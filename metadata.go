@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"runtime"
+	"time"
+
+	"github.com/hvpaiva/goaoc/internal/buildinfo"
+)
+
+// Metadata describes the conditions under which an answer was computed, so a result can be audited
+// and reproduced later: which input produced it, when, and with which versions of goaoc and Go.
+type Metadata struct {
+	// InputSHA256 is the hex-encoded SHA-256 checksum of the input given to the challenge.
+	InputSHA256 string
+
+	// Version is the version of goaoc, as recorded in the build information, or "(devel)" when unknown.
+	Version string
+
+	// GoVersion is the version of Go the program was built with.
+	GoVersion string
+
+	// Timestamp is the moment the run started.
+	Timestamp time.Time
+
+	// Seed is the seed the input was generated with, see WithGeneratedInput, or nil for other inputs.
+	Seed *int64
+}
+
+// newMetadata computes the Metadata of a run over input, started at the given time.
+func newMetadata(input string, timestamp time.Time) Metadata {
+	checksum := sha256.Sum256([]byte(input))
+
+	return Metadata{
+		InputSHA256: hex.EncodeToString(checksum[:]),
+		Version:     buildinfo.Version(),
+		GoVersion:   runtime.Version(),
+		Timestamp:   timestamp,
+	}
+}
+
+// WithMetadata creates a RunOption that writes the Metadata of each executed part to w, as a single
+// line after the answer is written. The metadata is always available in Result, regardless of this option.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithMetadata(os.Stderr))
+//	// part=1 answer=42 duration=1.2ms input_sha256=9f86d0... goaoc=v1.1.0 go=go1.23.0 timestamp=2024-12-07T05:00:03Z
+func WithMetadata(w io.Writer) RunOption {
+	return func(options *runOptions) error {
+		options.metadata = w
+
+		return nil
+	}
+}
+
+// writeMetadata writes the metadata line of result to w, ending with the seed of generated inputs.
+func writeMetadata[T comparable](w io.Writer, result Result[T]) error {
+	seed := ""
+	if result.Metadata.Seed != nil {
+		seed = fmt.Sprintf(" seed=%d", *result.Metadata.Seed)
+	}
+
+	_, err := fmt.Fprintf(w, "part=%d answer=%s duration=%s input_sha256=%s goaoc=%s go=%s timestamp=%s%s\n",
+		result.Part, formatAnswer(result.Answer), result.Duration, result.Metadata.InputSHA256,
+		result.Metadata.Version, result.Metadata.GoVersion, result.Metadata.Timestamp.Format(time.RFC3339), seed)
+	if err != nil {
+		return IOWriteError{Err: err}
+	}
+
+	return nil
+}
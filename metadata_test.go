@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc_test
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/goaoc"
+	"github.com/hvpaiva/goaoc/mock"
+)
+
+// inputSHA256 is the SHA-256 checksum of "input".
+const inputSHA256 = "c96c6d5be8d08a12e7b5cdc1b207fa6b2430974c86803d8891675e76fd992c20"
+
+func TestResultMetadata(t *testing.T) {
+	before := time.Now()
+
+	mok := mock.NewManager("1", nil, nil)
+	result, err := goaoc.RunResult("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok))
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	metadata := result.Metadata
+	if metadata.InputSHA256 != inputSHA256 {
+		t.Errorf("Expected input checksum %s, but got %s", inputSHA256, metadata.InputSHA256)
+	}
+
+	if metadata.GoVersion != runtime.Version() {
+		t.Errorf("Expected Go version %s, but got %s", runtime.Version(), metadata.GoVersion)
+	}
+
+	if metadata.Version == "" {
+		t.Error("Expected goaoc version to be set")
+	}
+
+	if metadata.Timestamp.Before(before) || metadata.Timestamp.After(time.Now()) {
+		t.Errorf("Expected timestamp to be the start of the run, but got %s", metadata.Timestamp)
+	}
+}
+
+func TestWithMetadata(t *testing.T) {
+	var buf bytes.Buffer
+
+	mok := mock.NewManager("2", nil, nil)
+	err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok), goaoc.WithMetadata(&buf))
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	line := buf.String()
+	if !strings.HasPrefix(line, "part=2 answer=24 duration=") || !strings.HasSuffix(line, "\n") {
+		t.Errorf("Unexpected metadata line: %s", line)
+	}
+
+	for _, field := range []string{"input_sha256=" + inputSHA256, "go=" + runtime.Version(), "goaoc=", "timestamp="} {
+		if !strings.Contains(line, field) {
+			t.Errorf("Expected metadata line to contain '%s', but got: %s", field, line)
+		}
+	}
+}
+
+func TestWithMetadataWriterFails(t *testing.T) {
+	mok := mock.NewManager("1", nil, nil)
+	err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok), goaoc.WithMetadata(failingWriter{}))
+
+	if err == nil || err.Error() != "failed to write input: write failed" {
+		t.Fatalf("Expected 'failed to write input: write failed' error, but got: %v", err)
+	}
+}
+
+var errWriteFailed = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write(_ []byte) (int, error) {
+	return 0, errWriteFailed
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// notificationTitle is the title of the notifications of WithNotifier.
+const notificationTitle = "Advent of Code"
+
+// Notifier sends notifications, such as desktop notifications, see WithNotifier.
+type Notifier interface {
+	// Notify sends a notification with the given title and message.
+	Notify(ctx context.Context, title, message string) error
+}
+
+// NotifierFunc is a function that implements the Notifier interface, e.g. to post to a chat or a phone.
+type NotifierFunc func(ctx context.Context, title, message string) error
+
+// Notify calls f(ctx, title, message).
+func (f NotifierFunc) Notify(ctx context.Context, title, message string) error {
+	return f(ctx, title, message)
+}
+
+// DesktopNotifier returns a Notifier showing native desktop notifications, through the osascript command on
+// macOS, or the notify-send command of libnotify on Linux and the BSDs. Other systems fail with
+// ErrNotifierUnsupported.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 12), WithSubmit(), WithNotifier(DesktopNotifier(), time.Minute))
+func DesktopNotifier() Notifier {
+	return desktopNotifier{goos: runtime.GOOS}
+}
+
+// desktopNotifier is the Notifier returned by DesktopNotifier, for the given OS.
+type desktopNotifier struct {
+	goos string
+}
+
+// Notify runs the notification command of the OS.
+func (n desktopNotifier) Notify(ctx context.Context, title, message string) error {
+	command := n.command(title, message)
+	if len(command) == 0 {
+		return fmt.Errorf("%w: %s", ErrNotifierUnsupported, n.goos)
+	}
+
+	if output, err := exec.CommandContext(ctx, command[0], command[1:]...).CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %w", strings.TrimSpace(string(output)), err)
+	}
+
+	return nil
+}
+
+// command returns the command showing the notification, or nil when the OS is not supported.
+func (n desktopNotifier) command(title, message string) []string {
+	switch n.goos {
+	case "darwin":
+		quote := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+		return []string{"osascript", "-e", fmt.Sprintf(`display notification "%s" with title "%s"`, quote.Replace(message), quote.Replace(title))}
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return []string{"notify-send", "--", title, message}
+	default:
+		return nil
+	}
+}
+
+// WithNotifier creates a RunOption that sends a notification with notifier when an answer submitted by
+// WithAutoSubmit is correct, e.g. "Day 12 Part 2: correct!", and when a part running for at least longRun
+// finishes, with its answer, so you can tab away during a long brute force. A zero longRun only notifies
+// correct answers. Notifications are a convenience, so failing to send one does not fail the run.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 12), WithNotifier(DesktopNotifier(), time.Minute))
+func WithNotifier(notifier Notifier, longRun time.Duration) RunOption {
+	return func(options *runOptions) error {
+		options.notifier = notifier
+		options.notifyAfter = longRun
+
+		return nil
+	}
+}
+
+// notify sends the message with the notifier of WithNotifier, if any, prefixed with the day when known.
+func (o *runOptions) notify(ctx context.Context, message string) {
+	if o.notifier == nil {
+		return
+	}
+
+	if o.day != 0 {
+		message = fmt.Sprintf("Day %d %s", o.day, message)
+	}
+
+	_ = o.notifier.Notify(ctx, notificationTitle, message)
+}
+
+// notifyLongRun notifies the answer of part, as configured by WithNotifier, when it ran for at least the
+// configured duration.
+func (o *runOptions) notifyLongRun(ctx context.Context, part Part, answer string, duration time.Duration) {
+	if o.notifyAfter <= 0 || duration < o.notifyAfter {
+		return
+	}
+
+	o.notify(ctx, fmt.Sprintf("Part %d finished in %s: %s", part, duration.Round(time.Second), answer))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithNotifier(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	submissions := 0
+	server := newAnswerServer(t, &submissions)
+
+	var notifications []string
+
+	notifier := NotifierFunc(func(_ context.Context, title, message string) error {
+		notifications = append(notifications, title+": "+message)
+
+		return errors.New("not shown")
+	})
+	challenges := []ChallengeE[int]{
+		func(string) (int, error) { return 0, nil },
+		func(string) (int, error) {
+			time.Sleep(5 * time.Millisecond)
+
+			return 3749, nil
+		},
+	}
+
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+	manager := DefaultConsoleManager{Env: mockEnv([]string{}, "", new(bytes.Buffer))}
+
+	_, err := runWith(context.Background(), &opts, challenges, WithManager(manager), WithPart(2), WithInputString("input"),
+		WithYear(2024), WithDay(7), WithSubmit(), WithNotifier(notifier, time.Millisecond))
+	if err != nil {
+		t.Fatalf("Expected no error, as notifications are best effort, but got: %v", err)
+	}
+
+	if len(notifications) != 2 || !strings.HasPrefix(notifications[0], "Advent of Code: Day 7 Part 2 finished in ") ||
+		!strings.HasSuffix(notifications[0], ": 3749") || notifications[1] != "Advent of Code: Day 7 Part 2: correct!" {
+		t.Errorf("Expected the long run and the correct answer to be notified, but got %q", notifications)
+	}
+}
+
+func TestDesktopNotifierCommand(t *testing.T) {
+	testCases := []struct {
+		goos     string
+		expected []string
+	}{
+		{"darwin", []string{"osascript", "-e", `display notification "Part \"2\": correct!" with title "Advent of Code"`}},
+		{"linux", []string{"notify-send", "--", "Advent of Code", `Part "2": correct!`}},
+		{"plan9", nil},
+	}
+
+	for _, tc := range testCases {
+		if command := (desktopNotifier{goos: tc.goos}).command("Advent of Code", `Part "2": correct!`); !reflect.DeepEqual(command, tc.expected) {
+			t.Errorf("%s: expected %q, but got %q", tc.goos, tc.expected, command)
+		}
+	}
+
+	if err := (desktopNotifier{goos: "plan9"}).Notify(context.Background(), "title", "message"); !errors.Is(err, ErrNotifierUnsupported) {
+		t.Errorf("Expected ErrNotifierUnsupported, but got: %v", err)
+	}
+}
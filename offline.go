@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// WithOffline creates a RunOption that disables every download, e.g. during a flight. Inputs of
+// WithInputDownload are then only taken from the InputCache or from the conventional locations of
+// WithInputDiscovery, failing with ErrOffline, which lists where they were looked for, instead of
+// hanging on the network. Offline mode is also enabled by setting GOAOC_OFFLINE to true, and it is
+// used automatically for a download when adventofcode.com cannot be reached.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 7), WithOffline())
+func WithOffline() RunOption {
+	return func(options *runOptions) error {
+		options.offline = true
+
+		return nil
+	}
+}
+
+// isOffline reports whether downloads are disabled, by WithOffline or by the GOAOC_OFFLINE environment variable.
+func (o *runOptions) isOffline() bool {
+	return o.offline || os.Getenv("GOAOC_OFFLINE") == "true"
+}
+
+// isNetworkError reports whether err is caused by the network, such as a failed DNS lookup or connection,
+// rather than by the server response.
+func isNetworkError(err error) bool {
+	var (
+		opErr  *net.OpError
+		dnsErr *net.DNSError
+	)
+
+	return errors.As(err, &opErr) || errors.As(err, &dnsErr)
+}
+
+// localInput returns the input of the given year and day from the cache, or from a conventional input file
+// in the module root of the working directory. If none exists, it fails with ErrOffline, listing the paths
+// looked for and wrapping cause, the network error that made the input unavailable, if any.
+func localInput(cache InputCache, year, day int, cause error) (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	candidates := append([]string{cache.Path(year, day)}, inputCandidates(dir, year, day)...)
+
+	path, err := findFile(candidates)
+	if errors.Is(err, ErrInputFileNotFound) {
+		err = fmt.Errorf("%w: looked for %s", ErrOffline, strings.Join(candidates, ", "))
+		if cause != nil {
+			err = fmt.Errorf("%w: %w", err, cause)
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return fileInput(os.ReadFile(path))
+}
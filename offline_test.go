@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDownloadInputOffline(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	downloads := 0
+	server := newInputServer(t, http.StatusOK, &downloads)
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir(), offline: true}
+	cache := InputCache{Dir: opts.cacheDir}
+
+	_, err := opts.downloadInput(context.Background(), 2024, 7)
+	if !errors.Is(err, ErrOffline) || !strings.Contains(err.Error(), cache.Path(2024, 7)) {
+		t.Fatalf("Expected ErrOffline listing the cache path, but got: %v", err)
+	}
+
+	if err := cache.Store(2024, 7, "cached\n"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	input, err := opts.downloadInput(context.Background(), 2024, 7)
+	if err != nil || input != "cached" {
+		t.Fatalf("Expected the cached input, but got %q (err: %v)", input, err)
+	}
+
+	if downloads != 0 {
+		t.Errorf("Expected no download while offline, but got %d", downloads)
+	}
+}
+
+func TestDownloadInputWithoutConnectivity(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+
+	_, err := opts.downloadInput(context.Background(), 2024, 7)
+	if !errors.Is(err, ErrOffline) || !isNetworkError(err) {
+		t.Errorf("Expected ErrOffline wrapping the network error, but got: %v", err)
+	}
+}
+
+func TestWithInputURLOffline(t *testing.T) {
+	t.Setenv("GOAOC_OFFLINE", "true")
+
+	var opts runOptions
+	if err := WithInputURL("https://aoc.example.com/2024/7", nil)(&opts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := opts.loadInput(context.Background()); !errors.Is(err, ErrOffline) {
+		t.Errorf("Expected ErrOffline, but got: %v", err)
+	}
+}
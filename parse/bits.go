@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// BitOrder is the order of the bits of a binary number or a row of a bit grid.
+type BitOrder int
+
+const (
+	// MSBFirst reads the first character as the most significant bit, like the binary numbers of puzzle inputs:
+	// "110" is 6.
+	MSBFirst BitOrder = iota
+
+	// LSBFirst reads the first character as the least significant bit, bit 0: "110" is 3.
+	LSBFirst
+)
+
+// Binary returns the number of the binary string s, of up to 64 "0" and "1" digits, read in the given order.
+// A digit other than "0" or "1" panics with its position, like a number of more than 64 bits.
+//
+// Example:
+//
+//	parse.Binary("10110", parse.MSBFirst) // 22
+func Binary(s string, order BitOrder) uint64 {
+	return bitset(s, order, func(i int, r rune) bool {
+		if r != '0' && r != '1' {
+			panic(fmt.Sprintf("parse: invalid binary digit %q at position %d of %q", r, i+1, s))
+		}
+
+		return r == '1'
+	})
+}
+
+// BitRows returns each line of s, a grid of up to 64 columns, as a bitset with the bits of the cells equal to one
+// set, read in the given order: with MSBFirst the first column is the most significant bit, and with LSBFirst
+// it is bit 0. A line of more than 64 cells panics with its position.
+//
+// Example:
+//
+//	parse.BitRows("#..\n.##", '#', parse.LSBFirst) // []uint64{0b001, 0b110}
+func BitRows(s string, one rune, order BitOrder) []uint64 {
+	lines := Lines(s)
+	rows := make([]uint64, len(lines))
+
+	for i, line := range lines {
+		if utf8.RuneCountInString(line) > 64 {
+			panic(fmt.Sprintf("parse: line %d has more than 64 cells", i+1))
+		}
+
+		rows[i] = bitset(line, order, func(_ int, r rune) bool { return r == one })
+	}
+
+	return rows
+}
+
+// Hex returns the bytes of the hex dump s, two hexadecimal digits per byte in either case, ignoring the white
+// space and line breaks between them. An invalid digit panics with its line, like a line with an odd number of
+// digits.
+//
+// Example:
+//
+//	parse.Hex("D2FE28\n0a 0b") // []byte{0xd2, 0xfe, 0x28, 0x0a, 0x0b}
+func Hex(s string) []byte {
+	bytes := []byte{}
+
+	for i, line := range Lines(s) {
+		digits := strings.Join(strings.Fields(line), "")
+
+		decoded, err := hex.DecodeString(digits)
+		if err != nil {
+			panic(fmt.Sprintf("parse: invalid hex dump at line %d: %v", i+1, err))
+		}
+
+		bytes = append(bytes, decoded...)
+	}
+
+	return bytes
+}
+
+// bitset returns the bits of s in the given order, set for the runes for which bit, given their index, is true.
+// More than 64 runes panic.
+func bitset(s string, order BitOrder, bit func(i int, r rune) bool) uint64 {
+	count := utf8.RuneCountInString(s)
+	if count > 64 {
+		panic(fmt.Sprintf("parse: %q has more than 64 bits", s))
+	}
+
+	var bits uint64
+
+	i := 0
+
+	for _, r := range s {
+		if bit(i, r) {
+			if order == LSBFirst {
+				bits |= 1 << i
+			} else {
+				bits |= 1 << (count - 1 - i)
+			}
+		}
+
+		i++
+	}
+
+	return bits
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+func TestBinary(t *testing.T) {
+	testCases := []struct {
+		input    string
+		order    parse.BitOrder
+		expected uint64
+	}{
+		{"", parse.MSBFirst, 0},
+		{"10110", parse.MSBFirst, 22},
+		{"10110", parse.LSBFirst, 13},
+		{strings.Repeat("1", 64), parse.MSBFirst, 1<<64 - 1},
+	}
+
+	for _, tc := range testCases {
+		if n := parse.Binary(tc.input, tc.order); n != tc.expected {
+			t.Errorf("Binary(%q, %d): expected %d, but got %d", tc.input, tc.order, tc.expected, n)
+		}
+	}
+
+	expectPanic(t, `parse: invalid binary digit '2' at position 3 of "102"`, func() { parse.Binary("102", parse.MSBFirst) })
+	expectPanic(t, `parse: "`+strings.Repeat("0", 65)+`" has more than 64 bits`, func() {
+		parse.Binary(strings.Repeat("0", 65), parse.MSBFirst)
+	})
+}
+
+func TestBitRows(t *testing.T) {
+	if rows := parse.BitRows("#..\r\n.##\n", '#', parse.MSBFirst); !reflect.DeepEqual(rows, []uint64{0b100, 0b011}) {
+		t.Errorf("Expected [4 3], but got %v", rows)
+	}
+
+	if rows := parse.BitRows("#..\n.##", '#', parse.LSBFirst); !reflect.DeepEqual(rows, []uint64{0b001, 0b110}) {
+		t.Errorf("Expected [1 6], but got %v", rows)
+	}
+
+	expectPanic(t, "parse: line 2 has more than 64 cells", func() {
+		parse.BitRows(".\n"+strings.Repeat(".", 65), '#', parse.MSBFirst)
+	})
+}
+
+func TestHex(t *testing.T) {
+	if bytes := parse.Hex("D2FE28\n0a 0b\n"); !reflect.DeepEqual(bytes, []byte{0xd2, 0xfe, 0x28, 0x0a, 0x0b}) {
+		t.Errorf("Expected d2fe280a0b, but got %x", bytes)
+	}
+
+	expectPanic(t, "parse: invalid hex dump at line 2: encoding/hex: invalid byte: U+0067 'g'", func() { parse.Hex("00\n0g") })
+	expectPanic(t, "parse: invalid hex dump at line 1: encoding/hex: odd length hex string", func() { parse.Hex("abc") })
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoMatch indicates that a line does not match the pattern it is parsed with. It is returned wrapped with
+// the line and the pattern.
+var ErrNoMatch = errors.New("line does not match the pattern")
+
+// ErrInvalidTarget indicates that the type a line is parsed into cannot hold it, such as a struct without a
+// field for a named group of the pattern, a field of an unsupported type, or a function that does not build it.
+// It is returned wrapped with the type.
+var ErrInvalidTarget = errors.New("invalid parse target")
+
+// ErrUnexpectedToken indicates that the next token of a Tokenizer is not the expected one. It is returned
+// wrapped with the token and its position.
+var ErrUnexpectedToken = errors.New("unexpected token")
+
+// ErrInvalidRange indicates that an interval, such as "2-8", is malformed or ends before it starts. It is
+// returned wrapped with the interval.
+var ErrInvalidRange = errors.New("invalid range")
+
+// ErrInvalidPair indicates that a field of a key-value block, see KVStruct, has no separator between its key and
+// its value. It is returned wrapped with the field and its position.
+var ErrInvalidPair = errors.New("invalid pair")
+
+// LineError indicates that a line of an input could not be parsed. It holds the number of the line, from 1,
+// and its text.
+type LineError struct {
+	Line int
+	Text string
+	Err  error
+}
+
+// Error implements the error interface for LineError.
+// It provides a message with the number and the text of the line.
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d %q: %v", e.Line, e.Text, e.Err)
+}
+
+// Unwrap allows access to the underlying error, following Go 1.13's error unwrapper design.
+func (e LineError) Unwrap() error {
+	return e.Err
+}
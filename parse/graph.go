@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// GraphOption configures how Graph builds the adjacency map of an input.
+type GraphOption func(options *graphOptions)
+
+// graphOptions holds the configuration of Graph.
+type graphOptions struct {
+	undirected bool
+}
+
+// Undirected makes Graph add each edge in both directions, for the inputs of paths such as "start-A". Edges are
+// directed by default, from the node before the separator to the ones after it.
+func Undirected() GraphOption {
+	return func(options *graphOptions) {
+		options.undirected = true
+	}
+}
+
+// Graph returns the adjacency map of the edges of input, one line per node: the node comes before sep, and its
+// neighbors after it, separated by commas or white space and optionally between parentheses, such as "start-A",
+// "AAA = (BBB, CCC)" or "jqt: rhn xhk nvd". Each node is a key of the map, even without neighbors, and its
+// neighbors are in the order of the input, without duplicates. A line without sep panics with its number.
+//
+// Example:
+//
+//	caves := parse.Graph("start-A\nA-end", "-", parse.Undirected())
+//	// map[string][]string{"start": {"A"}, "A": {"start", "end"}, "end": {"A"}}
+func Graph(input, sep string, options ...GraphOption) map[string][]string {
+	var opts graphOptions
+	for _, option := range options {
+		option(&opts)
+	}
+
+	adjacency := make(map[string][]string)
+
+	addEdge := func(from, to string) {
+		if !slices.Contains(adjacency[from], to) {
+			adjacency[from] = append(adjacency[from], to)
+		}
+	}
+
+	for i, line := range Lines(input) {
+		node, rest, ok := strings.Cut(line, sep)
+		if !ok {
+			panic(fmt.Sprintf("parse: line %d %q has no %q", i+1, line, sep))
+		}
+
+		node = strings.TrimSpace(node)
+		if _, ok := adjacency[node]; !ok {
+			adjacency[node] = []string{}
+		}
+
+		neighbors := strings.FieldsFunc(rest, func(r rune) bool {
+			return r == ',' || r == '(' || r == ')' || unicode.IsSpace(r)
+		})
+
+		for _, neighbor := range neighbors {
+			if _, ok := adjacency[neighbor]; !ok {
+				adjacency[neighbor] = []string{}
+			}
+
+			addEdge(node, neighbor)
+
+			if opts.undirected {
+				addEdge(neighbor, node)
+			}
+		}
+	}
+
+	return adjacency
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+func TestGraph(t *testing.T) {
+	testCases := []struct {
+		input    string
+		sep      string
+		options  []parse.GraphOption
+		expected map[string][]string
+	}{
+		{
+			"start-A\r\nA-end\nend-A\n", "-", []parse.GraphOption{parse.Undirected()},
+			map[string][]string{"start": {"A"}, "A": {"start", "end"}, "end": {"A"}},
+		},
+		{
+			"start-A\nA-end", "-", nil,
+			map[string][]string{"start": {"A"}, "A": {"end"}, "end": {}},
+		},
+		{
+			"AAA = (BBB, CCC)\nBBB = (AAA, ZZZ)", "=", nil,
+			map[string][]string{"AAA": {"BBB", "CCC"}, "BBB": {"AAA", "ZZZ"}, "CCC": {}, "ZZZ": {}},
+		},
+		{
+			"jqt: rhn xhk\nrhn: xhk", ":", []parse.GraphOption{parse.Undirected()},
+			map[string][]string{"jqt": {"rhn", "xhk"}, "rhn": {"jqt", "xhk"}, "xhk": {"jqt", "rhn"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		if graph := parse.Graph(tc.input, tc.sep, tc.options...); !reflect.DeepEqual(graph, tc.expected) {
+			t.Errorf("Graph(%q, %q): expected %v, but got %v", tc.input, tc.sep, tc.expected, graph)
+		}
+	}
+
+	expectPanic(t, `parse: line 2 "b c" has no "-"`, func() { parse.Graph("a-b\nb c", "-") })
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Interval is a range of integers from Start to End, both included, like the ranges of puzzle inputs such as
+// "2-8".
+type Interval struct {
+	Start, End int
+}
+
+// Len returns the number of integers of the interval.
+func (i Interval) Len() int {
+	return i.End - i.Start + 1
+}
+
+// Contains reports whether n is in the interval.
+func (i Interval) Contains(n int) bool {
+	return i.Start <= n && n <= i.End
+}
+
+// Covers reports whether every integer of o is in the interval.
+func (i Interval) Covers(o Interval) bool {
+	return i.Start <= o.Start && o.End <= i.End
+}
+
+// Overlaps reports whether the interval and o have an integer in common.
+func (i Interval) Overlaps(o Interval) bool {
+	return i.Start <= o.End && o.Start <= i.End
+}
+
+// Intersect returns the integers common to the interval and o, and reports whether there are any.
+func (i Interval) Intersect(o Interval) (Interval, bool) {
+	common := Interval{Start: max(i.Start, o.Start), End: min(i.End, o.End)}
+
+	return common, common.Start <= common.End
+}
+
+// String returns the interval as parsed by Range, e.g. "2-8".
+func (i Interval) String() string {
+	return fmt.Sprintf("%d-%d", i.Start, i.End)
+}
+
+// Range parses the interval s, two integers separated by a dash such as "2-8" or "-3--1", ignoring the white
+// space around them. A malformed interval, or one ending before it starts, fails with ErrInvalidRange.
+//
+// Example:
+//
+//	r, err := parse.Range("2-8") // parse.Interval{Start: 2, End: 8}
+func Range(s string) (Interval, error) {
+	trimmed := strings.TrimSpace(s)
+
+	// The dash of a negative start is not the separator.
+	sep := strings.Index(strings.TrimPrefix(trimmed, "-"), "-")
+	if sep < 0 {
+		return Interval{}, fmt.Errorf("%w: %q has no dash", ErrInvalidRange, s)
+	}
+
+	sep += len(trimmed) - len(strings.TrimPrefix(trimmed, "-"))
+
+	start, errStart := strconv.Atoi(strings.TrimSpace(trimmed[:sep]))
+	end, errEnd := strconv.Atoi(strings.TrimSpace(trimmed[sep+1:]))
+
+	if errStart != nil || errEnd != nil {
+		return Interval{}, fmt.Errorf("%w: %q", ErrInvalidRange, s)
+	}
+
+	if end < start {
+		return Interval{}, fmt.Errorf("%w: %q ends before it starts", ErrInvalidRange, s)
+	}
+
+	return Interval{Start: start, End: end}, nil
+}
+
+// RangePair parses the pair of intervals s, separated by a comma such as "2-8,3-7", like Range.
+//
+// Example:
+//
+//	a, b, err := parse.RangePair("2-8,3-7")
+//	if a.Covers(b) || b.Covers(a) {
+//	    count++
+//	}
+func RangePair(s string) (Interval, Interval, error) {
+	first, second, ok := strings.Cut(s, ",")
+	if !ok {
+		return Interval{}, Interval{}, fmt.Errorf("%w: %q is not a pair", ErrInvalidRange, s)
+	}
+
+	a, err := Range(first)
+	if err != nil {
+		return Interval{}, Interval{}, err
+	}
+
+	b, err := Range(second)
+	if err != nil {
+		return Interval{}, Interval{}, err
+	}
+
+	return a, b, nil
+}
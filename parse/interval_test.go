@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+func TestRange(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected parse.Interval
+	}{
+		{"2-8", parse.Interval{Start: 2, End: 8}},
+		{" 3 - 3 ", parse.Interval{Start: 3, End: 3}},
+		{"-3--1", parse.Interval{Start: -3, End: -1}},
+		{"-3-4", parse.Interval{Start: -3, End: 4}},
+	}
+
+	for _, tc := range testCases {
+		if r, err := parse.Range(tc.input); err != nil || r != tc.expected {
+			t.Errorf("Range(%q): expected %v, but got %v, %v", tc.input, tc.expected, r, err)
+		}
+	}
+
+	for _, input := range []string{"", "2", "2-", "a-8", "8-2", "2-8-9"} {
+		if _, err := parse.Range(input); !errors.Is(err, parse.ErrInvalidRange) {
+			t.Errorf("Range(%q): expected ErrInvalidRange, but got: %v", input, err)
+		}
+	}
+}
+
+func TestRangePair(t *testing.T) {
+	a, b, err := parse.RangePair("2-8,3-7")
+	if err != nil || a != (parse.Interval{Start: 2, End: 8}) || b != (parse.Interval{Start: 3, End: 7}) {
+		t.Fatalf("Expected 2-8 and 3-7, but got %v, %v, %v", a, b, err)
+	}
+
+	if !a.Covers(b) || b.Covers(a) || !a.Overlaps(b) || a.Len() != 7 || !b.Contains(7) || b.Contains(8) {
+		t.Errorf("Expected 2-8 to cover 3-7")
+	}
+
+	if common, ok := a.Intersect(parse.Interval{Start: 8, End: 9}); !ok || common.String() != "8-8" {
+		t.Errorf("Expected the intersection 8-8, but got %v, %v", common, ok)
+	}
+
+	if _, ok := b.Intersect(parse.Interval{Start: 8, End: 9}); ok || b.Overlaps(parse.Interval{Start: 8, End: 9}) {
+		t.Errorf("Expected 3-7 not to overlap 8-9")
+	}
+
+	for _, input := range []string{"2-8", "2-8,x"} {
+		if _, _, err := parse.RangePair(input); !errors.Is(err, parse.ErrInvalidRange) {
+			t.Errorf("RangePair(%q): expected ErrInvalidRange, but got: %v", input, err)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// KV returns the key-value pairs of block, such as "ecl:gry pid:860033327\nhcl:#fffffd", separated by line
+// breaks and pairSep, or by any white space when pairSep is empty, with kvSep between the key and the value of
+// each pair. The last value of a repeated key wins. A pair without kvSep panics with its line and position.
+//
+// Example:
+//
+//	parse.KV("ecl:gry pid:860\nhcl:#fffffd", " ", ":") // map[string]string{"ecl": "gry", "pid": "860", "hcl": "#fffffd"}
+func KV(block, pairSep, kvSep string) map[string]string {
+	pairs, err := kvPairs(block, pairSep, kvSep)
+	if err != nil {
+		panic("parse: " + err.Error())
+	}
+
+	kv := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		kv[pair.key] = pair.value
+	}
+
+	return kv
+}
+
+// kvPair is a key-value pair of a block parsed by KV.
+type kvPair struct {
+	key, value string
+}
+
+// kvPairs returns the key-value pairs of block, like KV, in the order of the block. A pair without kvSep fails
+// with ErrInvalidPair.
+func kvPairs(block, pairSep, kvSep string) ([]kvPair, error) {
+	var pairs []kvPair
+
+	for i, line := range Lines(block) {
+		var fields []string
+		if pairSep == "" {
+			fields = strings.Fields(line)
+		} else {
+			fields = strings.Split(line, pairSep)
+		}
+
+		for j, field := range fields {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+
+			key, value, ok := strings.Cut(field, kvSep)
+			if !ok {
+				return nil, fmt.Errorf("%w %q at line %d, field %d", ErrInvalidPair, field, i+1, j+1)
+			}
+
+			pairs = append(pairs, kvPair{key: strings.TrimSpace(key), value: strings.TrimSpace(value)})
+		}
+	}
+
+	return pairs, nil
+}
+
+// KVStruct parses the key-value pairs of block, like KV, into a struct of type T: each key sets the field of the
+// same name, ignoring case, or with a `parse:"key"` tag, converted to the type of the field like Regex. The keys
+// without field are ignored, and the fields without key are left unset. The pairs are set in the order of the
+// block, so the first value that cannot be converted fails with the error of its conversion. A pair without
+// kvSep fails with ErrInvalidPair, and a target that is not a struct or a field of another type with
+// ErrInvalidTarget.
+//
+// Example:
+//
+//	type passport struct {
+//	    BirthYear int    `parse:"byr"`
+//	    EyeColor  string `parse:"ecl"`
+//	}
+//
+//	p, err := parse.KVStruct[passport](block, " ", ":")
+func KVStruct[T any](block, pairSep, kvSep string) (T, error) {
+	var target T
+
+	value := reflect.ValueOf(&target).Elem()
+	if value.Kind() != reflect.Struct {
+		return target, fmt.Errorf("%w: %s is not a struct", ErrInvalidTarget, value.Type())
+	}
+
+	pairs, err := kvPairs(block, pairSep, kvSep)
+	if err != nil {
+		return target, err
+	}
+
+	for _, pair := range pairs {
+		field, ok := fieldOf(value, pair.key)
+		if !ok {
+			continue
+		}
+
+		if err := setField(field, pair.value); err != nil {
+			return target, fmt.Errorf("key %s: %w", pair.key, err)
+		}
+	}
+
+	return target, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+type passport struct {
+	BirthYear int    `parse:"byr"`
+	EyeColor  string `parse:"ecl"`
+	PID       string
+}
+
+func TestKV(t *testing.T) {
+	testCases := []struct {
+		block, pairSep, kvSep string
+		expected              map[string]string
+	}{
+		{"", " ", ":", map[string]string{}},
+		{"ecl:gry  pid:860\r\nhcl:#fffffd\n", "", ":", map[string]string{"ecl": "gry", "pid": "860", "hcl": "#fffffd"}},
+		{"a=1, b = 2,\nc=3=4", ",", "=", map[string]string{"a": "1", "b": "2", "c": "3=4"}},
+	}
+
+	for _, tc := range testCases {
+		if pairs := parse.KV(tc.block, tc.pairSep, tc.kvSep); !reflect.DeepEqual(pairs, tc.expected) {
+			t.Errorf("KV(%q, %q, %q): expected %v, but got %v", tc.block, tc.pairSep, tc.kvSep, tc.expected, pairs)
+		}
+	}
+
+	expectPanic(t, `parse: invalid pair "hcl" at line 2, field 1`, func() { parse.KV("ecl:gry\nhcl", " ", ":") })
+}
+
+func TestKVStruct(t *testing.T) {
+	p, err := parse.KVStruct[passport]("ecl:gry pid:860033327 cid:147\nbyr:1937", " ", ":")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if expected := (passport{BirthYear: 1937, EyeColor: "gry", PID: "860033327"}); p != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, p)
+	}
+
+	if _, err := parse.KVStruct[passport]("byr:19x7", " ", ":"); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected a syntax error, but got: %v", err)
+	}
+
+	// The pairs are set in the order of the block, so the same error is reported every time.
+	for range 10 {
+		_, err := parse.KVStruct[passport]("ecl:gry byr:19x7 pid:1 cid:x\nbyr:x", " ", ":")
+		if expected := `key byr: strconv.ParseInt: parsing "19x7": invalid syntax`; err == nil || err.Error() != expected {
+			t.Fatalf("Expected the error %q of the first invalid value, but got: %v", expected, err)
+		}
+	}
+
+	if _, err := parse.KVStruct[passport]("ecl:gry\nhcl", " ", ":"); !errors.Is(err, parse.ErrInvalidPair) || err.Error() != `invalid pair "hcl" at line 2, field 1` {
+		t.Errorf("Expected ErrInvalidPair instead of a panic, but got: %v", err)
+	}
+
+	if _, err := parse.KVStruct[string]("a:b", " ", ":"); !errors.Is(err, parse.ErrInvalidTarget) {
+		t.Errorf("Expected ErrInvalidTarget for a string target, but got: %v", err)
+	}
+}
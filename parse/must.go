@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MustInt returns the integer of s, ignoring the white space around it, for the one-liners of solutions that
+// would otherwise ignore the error of strconv.Atoi. A token that is not an integer panics with it.
+//
+// Example:
+//
+//	n := parse.MustInt(fields[1])
+func MustInt(s string) int {
+	return must(s, "integer", strconv.Atoi)
+}
+
+// MustInt64 returns the 64-bit integer of s, ignoring the white space around it, like MustInt.
+func MustInt64(s string) int64 {
+	return must(s, "integer", func(s string) (int64, error) {
+		return strconv.ParseInt(s, 10, 64)
+	})
+}
+
+// MustFloat returns the floating-point number of s, ignoring the white space around it, like MustInt.
+func MustFloat(s string) float64 {
+	return must(s, "number", func(s string) (float64, error) {
+		return strconv.ParseFloat(s, 64)
+	})
+}
+
+// MustAtoiSlice returns the integers of tokens, such as the result of strings.Split, ignoring the white space
+// around each. A token that is not an integer panics with it and its position in tokens, from 1.
+//
+// Example:
+//
+//	parse.MustAtoiSlice(strings.Split("3,4, 5", ",")) // []int{3, 4, 5}
+func MustAtoiSlice(tokens []string) []int {
+	values := make([]int, len(tokens))
+
+	for i, token := range tokens {
+		value, err := strconv.Atoi(strings.TrimSpace(token))
+		if err != nil {
+			panic(fmt.Sprintf("parse: invalid integer %q at position %d", token, i+1))
+		}
+
+		values[i] = value
+	}
+
+	return values
+}
+
+// must converts s, without the white space around it, with convert, panicking with s if it is not a valid kind.
+func must[T any](s, kind string, convert func(s string) (T, error)) T {
+	value, err := convert(strings.TrimSpace(s))
+	if err != nil {
+		panic(fmt.Sprintf("parse: invalid %s %q", kind, s))
+	}
+
+	return value
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+func TestMust(t *testing.T) {
+	if n := parse.MustInt(" -42\n"); n != -42 {
+		t.Errorf("MustInt: expected -42, but got %d", n)
+	}
+
+	if n := parse.MustInt64("9007199254740993"); n != 9007199254740993 {
+		t.Errorf("MustInt64: expected 9007199254740993, but got %d", n)
+	}
+
+	if f := parse.MustFloat("1.5"); f != 1.5 {
+		t.Errorf("MustFloat: expected 1.5, but got %v", f)
+	}
+
+	if values := parse.MustAtoiSlice(strings.Split("3,4, 5", ",")); !reflect.DeepEqual(values, []int{3, 4, 5}) {
+		t.Errorf("MustAtoiSlice: expected [3 4 5], but got %v", values)
+	}
+
+	expectPanic(t, `parse: invalid integer "4x"`, func() { parse.MustInt("4x") })
+	expectPanic(t, `parse: invalid integer "99999999999999999999"`, func() { parse.MustInt64("99999999999999999999") })
+	expectPanic(t, `parse: invalid number "."`, func() { parse.MustFloat(".") })
+	expectPanic(t, `parse: invalid integer "" at position 3`, func() { parse.MustAtoiSlice(strings.Split("1,2,", ",")) })
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+// Package parse provides helpers turning puzzle inputs into Go values, such as their lines or numbers, so
+// solutions do not re-implement them every day. The helpers accept "\n" and "\r\n" line breaks and ignore
+// trailing ones. Helpers without an error result are meant for well-formed puzzle inputs, and panic with the
+// position of a malformed token, which goaoc reports as a ChallengePanicError.
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Lines splits s into lines, accepting "\n" and "\r\n" line breaks. Trailing line breaks are ignored, so an
+// input ending with a line break has no empty last line, and an empty input has no lines.
+//
+// Example:
+//
+//	parse.Lines("a\r\nb\n") // []string{"a", "b"}
+func Lines(s string) []string {
+	s = strings.TrimRight(strings.ReplaceAll(s, "\r\n", "\n"), "\r\n")
+	if s == "" {
+		return []string{}
+	}
+
+	return strings.Split(s, "\n")
+}
+
+// Blocks splits s into blocks of lines separated by one or more blank lines, such as the groups of records of
+// a puzzle input, accepting "\n" and "\r\n" line breaks. Lines of white space only are blank, and the blank
+// lines are not part of any block.
+//
+// Example:
+//
+//	parse.Blocks("1000\n2000\n\n3000\n") // [][]string{{"1000", "2000"}, {"3000"}}
+func Blocks(s string) [][]string {
+	blocks := [][]string{}
+
+	var block []string
+
+	for _, line := range Lines(s) {
+		if strings.TrimSpace(line) != "" {
+			block = append(block, line)
+
+			continue
+		}
+
+		if block != nil {
+			blocks = append(blocks, block)
+			block = nil
+		}
+	}
+
+	if block != nil {
+		blocks = append(blocks, block)
+	}
+
+	return blocks
+}
+
+// Ints returns the integers of s, separated by any white space, including line breaks.
+// A field that is not an integer panics with its line and position.
+//
+// Example:
+//
+//	parse.Ints("3   4\n-1 2\n") // []int{3, 4, -1, 2}
+func Ints(s string) []int {
+	return fields(s, "integer", strconv.Atoi)
+}
+
+// NumbersSep returns the integers of s, separated by any white space, including line breaks, and by any of the
+// separators seps, such as "," or "|". Consecutive separators are a single one, so mixed delimiters such as ", "
+// or " | " need no separator of their own. A field that is not an integer panics with its line and position.
+//
+// Example:
+//
+//	parse.NumbersSep("41 48 | 83 86,6", "|", ",") // []int{41, 48, 83, 86, 6}
+func NumbersSep(s string, seps ...string) []int {
+	replacements := make([]string, 0, 2*len(seps))
+
+	for _, sep := range seps {
+		if sep != "" {
+			replacements = append(replacements, sep, " ")
+		}
+	}
+
+	return fields(strings.NewReplacer(replacements...).Replace(s), "integer", strconv.Atoi)
+}
+
+// Floats returns the floating-point numbers of s, separated by any white space, including line breaks.
+// A field that is not a number panics with its line and position.
+//
+// Example:
+//
+//	parse.Floats("1.5 -2\n3e2") // []float64{1.5, -2, 300}
+func Floats(s string) []float64 {
+	return fields(s, "number", func(field string) (float64, error) {
+		return strconv.ParseFloat(field, 64)
+	})
+}
+
+// DigitGrid returns the digits of each line of s, for the inputs drawn as a rectangle of single digits, such as
+// heights or risk levels. A character that is not a digit panics with its line and column.
+//
+// Example:
+//
+//	parse.DigitGrid("219\n398\n") // [][]int{{2, 1, 9}, {3, 9, 8}}
+func DigitGrid(s string) [][]int {
+	lines := Lines(s)
+	digits := make([][]int, len(lines))
+
+	for i, line := range lines {
+		digits[i] = make([]int, 0, len(line))
+
+		for j, r := range []rune(line) {
+			if r < '0' || r > '9' {
+				panic(fmt.Sprintf("parse: invalid digit %q at line %d, column %d", r, i+1, j+1))
+			}
+
+			digits[i] = append(digits[i], int(r-'0'))
+		}
+	}
+
+	return digits
+}
+
+// fields converts the white-space separated fields of s with convert, panicking with the position of the first
+// field that is not a valid kind.
+func fields[T any](s, kind string, convert func(field string) (T, error)) []T {
+	values := []T{}
+
+	for i, line := range Lines(s) {
+		for j, field := range strings.Fields(line) {
+			value, err := convert(field)
+			if err != nil {
+				panic(fmt.Sprintf("parse: invalid %s %q at line %d, field %d", kind, field, i+1, j+1))
+			}
+
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
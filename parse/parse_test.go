@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+// expectPanic fails the test unless f panics with the expected message.
+func expectPanic(t *testing.T, expected string, f func()) {
+	t.Helper()
+
+	defer func() {
+		t.Helper()
+
+		if recovered := recover(); recovered != expected {
+			t.Errorf("Expected a panic with %q, but got %v", expected, recovered)
+		}
+	}()
+
+	f()
+}
+
+func TestLines(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected []string
+	}{
+		{"", []string{}},
+		{"\n\n", []string{}},
+		{"a\r\nb\n", []string{"a", "b"}},
+		{"a\n\nb", []string{"a", "", "b"}},
+	}
+
+	for _, tc := range testCases {
+		if lines := parse.Lines(tc.input); !reflect.DeepEqual(lines, tc.expected) {
+			t.Errorf("Lines(%q): expected %q, but got %q", tc.input, tc.expected, lines)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected [][]string
+	}{
+		{"", [][]string{}},
+		{"1000\r\n2000\r\n\r\n3000\r\n", [][]string{{"1000", "2000"}, {"3000"}}},
+		{"\nab\n  \n\n\nc\n\n", [][]string{{"ab"}, {"c"}}},
+	}
+
+	for _, tc := range testCases {
+		if blocks := parse.Blocks(tc.input); !reflect.DeepEqual(blocks, tc.expected) {
+			t.Errorf("Blocks(%q): expected %q, but got %q", tc.input, tc.expected, blocks)
+		}
+	}
+}
+
+func TestInts(t *testing.T) {
+	if ints := parse.Ints("3   4\r\n-1\t+2\n\n"); !reflect.DeepEqual(ints, []int{3, 4, -1, 2}) {
+		t.Errorf("Expected [3 4 -1 2], but got %v", ints)
+	}
+
+	if ints := parse.Ints(""); ints == nil || len(ints) != 0 {
+		t.Errorf("Expected no integers, but got %#v", ints)
+	}
+
+	expectPanic(t, `parse: invalid integer "x" at line 2, field 2`, func() { parse.Ints("1 2\n3 x") })
+}
+
+func TestFloats(t *testing.T) {
+	if floats := parse.Floats("1.5 -2\n3e2"); !reflect.DeepEqual(floats, []float64{1.5, -2, 300}) {
+		t.Errorf("Expected [1.5 -2 300], but got %v", floats)
+	}
+
+	expectPanic(t, `parse: invalid number "1,5" at line 1, field 1`, func() { parse.Floats("1,5") })
+}
+
+func TestNumbersSep(t *testing.T) {
+	testCases := []struct {
+		input    string
+		seps     []string
+		expected []int
+	}{
+		{"", []string{","}, []int{}},
+		{"1,2, 3\n4", []string{","}, []int{1, 2, 3, 4}},
+		{"41 48 | 83 86,,6", []string{"|", ","}, []int{41, 48, 83, 86, 6}},
+		{"-1;-2", []string{";"}, []int{-1, -2}},
+		{"1 2", nil, []int{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		if numbers := parse.NumbersSep(tc.input, tc.seps...); !reflect.DeepEqual(numbers, tc.expected) {
+			t.Errorf("NumbersSep(%q, %q): expected %v, but got %v", tc.input, tc.seps, tc.expected, numbers)
+		}
+	}
+
+	expectPanic(t, `parse: invalid integer "x" at line 2, field 2`, func() { parse.NumbersSep("1,2\n3,x", ",") })
+}
+
+func TestDigitGrid(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected [][]int
+	}{
+		{"", [][]int{}},
+		{"219\r\n398\n", [][]int{{2, 1, 9}, {3, 9, 8}}},
+	}
+
+	for _, tc := range testCases {
+		if digits := parse.DigitGrid(tc.input); !reflect.DeepEqual(digits, tc.expected) {
+			t.Errorf("DigitGrid(%q): expected %v, but got %v", tc.input, tc.expected, digits)
+		}
+	}
+
+	expectPanic(t, "parse: invalid digit '.' at line 2, column 3", func() { parse.DigitGrid("123\n45.") })
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// patterns caches the compiled patterns of Regex, by pattern.
+var patterns sync.Map
+
+// Regex parses line into a struct of type T with the regular expression pattern: each named group of the
+// pattern sets the field of the same name, ignoring case, or with a `parse:"name"` tag. The text of a group is
+// converted to the type of its field, any integer, floating-point or string type, and the fields of optional
+// groups that did not match are left unset. Unnamed groups are ignored.
+// A line that does not match fails with ErrNoMatch, and a text that cannot be converted with the error of the
+// conversion. A named group without field, or a field of another type, fails with ErrInvalidTarget.
+//
+// Example:
+//
+//	type game struct {
+//	    ID    int
+//	    Draws string
+//	}
+//
+//	g, err := parse.Regex[game]("Game 12: 3 blue, 4 red", `Game (?P<id>\d+): (?P<draws>.*)`)
+func Regex[T any](line, pattern string) (T, error) {
+	var target T
+
+	re, err := compile(pattern)
+	if err != nil {
+		return target, err
+	}
+
+	match := re.FindStringSubmatchIndex(line)
+	if match == nil {
+		return target, fmt.Errorf("%w: %q does not match %q", ErrNoMatch, line, pattern)
+	}
+
+	value := reflect.ValueOf(&target).Elem()
+	if value.Kind() != reflect.Struct {
+		return target, fmt.Errorf("%w: %s is not a struct", ErrInvalidTarget, value.Type())
+	}
+
+	for i, name := range re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+
+		field, ok := fieldOf(value, name)
+		if !ok {
+			return target, fmt.Errorf("%w: %s has no field for the group %s", ErrInvalidTarget, value.Type(), name)
+		}
+
+		// An optional group that did not match leaves its field unset.
+		if match[2*i] < 0 {
+			continue
+		}
+
+		if err := setField(field, line[match[2*i]:match[2*i+1]]); err != nil {
+			return target, fmt.Errorf("group %s of %q: %w", name, line, err)
+		}
+	}
+
+	return target, nil
+}
+
+// compile returns the compiled pattern, from the cache of patterns when already compiled.
+func compile(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	patterns.Store(pattern, re)
+
+	return re, nil
+}
+
+// fieldOf returns the exported field of the struct value tagged with `parse:"name"`, or else named name,
+// ignoring case.
+func fieldOf(value reflect.Value, name string) (reflect.Value, bool) {
+	fields := reflect.VisibleFields(value.Type())
+
+	for _, field := range fields {
+		if field.IsExported() && field.Tag.Get("parse") == name {
+			return value.FieldByIndex(field.Index), true
+		}
+	}
+
+	for _, field := range fields {
+		if field.IsExported() && field.Tag.Get("parse") == "" && strings.EqualFold(field.Name, name) {
+			return value.FieldByIndex(field.Index), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// setField sets field to text, converted to its type.
+func setField(field reflect.Value, text string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(text)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(text, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("%w: unsupported field type %s", ErrInvalidTarget, field.Type())
+	}
+
+	return nil
+}
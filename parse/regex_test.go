@@ -0,0 +1,61 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+type claim struct {
+	ID     int
+	X, Y   uint8
+	Owner  string `parse:"name"`
+	Weight float64
+}
+
+const claimPattern = `#(?P<id>\d+) by (?P<name>\w+) @ (?P<x>\d+),(?P<y>\d+)(?:: (?P<weight>[\d.]+))?`
+
+func TestRegex(t *testing.T) {
+	c, err := parse.Regex[claim]("#123 by elf @ 3,2: 0.5", claimPattern)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if expected := (claim{ID: 123, X: 3, Y: 2, Owner: "elf", Weight: 0.5}); c != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, c)
+	}
+
+	// The weight is optional.
+	c, err = parse.Regex[claim]("#7 by elf @ 1,1", claimPattern)
+	if err != nil || c.Weight != 0 || c.ID != 7 {
+		t.Errorf("Expected claim 7 without weight, but got %+v, %v", c, err)
+	}
+}
+
+func TestRegexErrors(t *testing.T) {
+	testCases := []struct {
+		line, pattern string
+		expected      error
+	}{
+		{"#1 by elf 3,2", claimPattern, parse.ErrNoMatch},
+		{"#1 by elf @ 3,2", `#(?P<id>\d+) by (?P<owner>\w+)`, parse.ErrInvalidTarget},
+		{"#1 by elf @ 300,2", claimPattern, strconv.ErrRange},
+		{"#1 by elf @ 3,2: ...", claimPattern, strconv.ErrSyntax},
+	}
+
+	for _, tc := range testCases {
+		if _, err := parse.Regex[claim](tc.line, tc.pattern); !errors.Is(err, tc.expected) {
+			t.Errorf("Regex(%q, %q): expected %v, but got: %v", tc.line, tc.pattern, tc.expected, err)
+		}
+	}
+
+	if _, err := parse.Regex[int]("1", `(?P<n>\d)`); !errors.Is(err, parse.ErrInvalidTarget) {
+		t.Errorf("Expected ErrInvalidTarget for an int target, but got: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Each converts each line of input with convert and returns the converted values, in order. The first line that
+// fails to convert stops it with a LineError holding the number of the line and wrapping the error of convert.
+//
+// Example:
+//
+//	masses, err := parse.Each(input, strconv.Atoi)
+func Each[T any](input string, convert func(line string) (T, error)) ([]T, error) {
+	lines := Lines(input)
+	values := make([]T, 0, len(lines))
+
+	for i, line := range lines {
+		value, err := convert(line)
+		if err != nil {
+			return nil, LineError{Line: i + 1, Text: line, Err: err}
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// ScanLines scans each line of input with the fmt-style format, like fmt.Sscanf, and returns the values built by
+// build from the scanned values. The parameters of build, a function returning T, give the types of the values
+// of the format. A line that does not match the whole format fails with a LineError wrapping ErrNoMatch, and a
+// build function of another type with ErrInvalidTarget.
+//
+// Example:
+//
+//	moves, err := parse.ScanLines[move](input, "move %d from %d to %d", func(n, from, to int) move {
+//	    return move{n, from, to}
+//	})
+func ScanLines[T any](input, format string, build any) ([]T, error) {
+	fn := reflect.ValueOf(build)
+	target := reflect.TypeFor[T]()
+
+	if fn.Kind() != reflect.Func || fn.Type().NumOut() != 1 || fn.Type().Out(0) != target {
+		return nil, fmt.Errorf("%w: %T does not return %s", ErrInvalidTarget, build, target)
+	}
+
+	args := make([]reflect.Value, fn.Type().NumIn())
+	values := make([]any, len(args), len(args)+1)
+
+	for i := range args {
+		args[i] = reflect.New(fn.Type().In(i))
+		values[i] = args[i].Interface()
+	}
+
+	// An extra value scanned after the format shows that the line does not end with it.
+	var rest string
+
+	values = append(values, &rest)
+
+	results := make([]T, 0)
+
+	for i, line := range Lines(input) {
+		n, err := fmt.Sscanf(line, format+"%s", values...)
+
+		switch {
+		case n < len(args):
+			return nil, LineError{Line: i + 1, Text: line, Err: fmt.Errorf("%w %q: %w", ErrNoMatch, format, err)}
+		case n > len(args):
+			return nil, LineError{Line: i + 1, Text: line, Err: fmt.Errorf("%w %q: unexpected %q", ErrNoMatch, format, rest)}
+		}
+
+		in := make([]reflect.Value, len(args))
+		for j, arg := range args {
+			in[j] = arg.Elem()
+		}
+
+		result, _ := fn.Call(in)[0].Interface().(T)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+type move struct {
+	count, from, to int
+}
+
+func newMove(count, from, to int) move {
+	return move{count, from, to}
+}
+
+func TestEach(t *testing.T) {
+	masses, err := parse.Each("12\r\n1969\n", strconv.Atoi)
+	if err != nil || !reflect.DeepEqual(masses, []int{12, 1969}) {
+		t.Errorf("Expected [12 1969], but got %v, %v", masses, err)
+	}
+
+	_, err = parse.Each("12\n19x69", strconv.Atoi)
+
+	var lineErr parse.LineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 2 || lineErr.Text != "19x69" || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected a syntax error at line 2, but got: %v", err)
+	}
+}
+
+func TestScanLines(t *testing.T) {
+	moves, err := parse.ScanLines[move]("move 1 from 2 to 1\r\nmove 13 from 1 to 3\n", "move %d from %d to %d", newMove)
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if expected := []move{{1, 2, 1}, {13, 1, 3}}; !reflect.DeepEqual(moves, expected) {
+		t.Errorf("Expected %v, but got %v", expected, moves)
+	}
+
+	names, err := parse.ScanLines[string]("a -> 1\nb -> 2", "%s -> %d", func(name string, _ int) string { return name })
+	if err != nil || !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], but got %v, %v", names, err)
+	}
+}
+
+func TestScanLinesErrors(t *testing.T) {
+	testCases := []struct {
+		input string
+		line  int
+	}{
+		{"move 1 from 2 to 1\nmove x from 2 to 1", 2},
+		{"move 1 from 2", 1},
+		{"move 1 from 2 to 1 twice", 1},
+	}
+
+	for _, tc := range testCases {
+		_, err := parse.ScanLines[move](tc.input, "move %d from %d to %d", newMove)
+
+		var lineErr parse.LineError
+		if !errors.As(err, &lineErr) || lineErr.Line != tc.line || !errors.Is(err, parse.ErrNoMatch) {
+			t.Errorf("ScanLines(%q): expected ErrNoMatch at line %d, but got: %v", tc.input, tc.line, err)
+		}
+	}
+
+	if _, err := parse.ScanLines[move]("1", "%d", func(int) int { return 0 }); !errors.Is(err, parse.ErrInvalidTarget) {
+		t.Errorf("Expected ErrInvalidTarget for a function not returning a move, but got: %v", err)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind is the kind of a Token.
+type TokenKind int
+
+const (
+	// EOF is the kind of the token returned at the end of the input, with an empty text.
+	EOF TokenKind = iota
+
+	// Number is the kind of the tokens of decimal digits, such as "42". A minus sign is a Punct token of its own.
+	Number
+
+	// Ident is the kind of the tokens of letters, digits and underscores starting with a letter or an underscore,
+	// such as "x" or "jmp".
+	Ident
+
+	// Punct is the kind of the tokens of a single other character, such as "(" or "+".
+	Punct
+)
+
+// String returns the name of the kind, e.g. "number".
+func (k TokenKind) String() string {
+	switch k {
+	case EOF:
+		return "end of input"
+	case Number:
+		return "number"
+	case Ident:
+		return "identifier"
+	case Punct:
+		return "punctuation"
+	default:
+		return fmt.Sprintf("TokenKind(%d)", int(k))
+	}
+}
+
+// Token is a token of the input of a Tokenizer, at the given line and column, both starting at 1.
+type Token struct {
+	Kind   TokenKind
+	Text   string
+	Line   int
+	Column int
+}
+
+// String returns the text of the token and its position, e.g. `"+" at line 1, column 3`.
+func (t Token) String() string {
+	if t.Kind == EOF {
+		return fmt.Sprintf("end of input at line %d, column %d", t.Line, t.Column)
+	}
+
+	return fmt.Sprintf("%q at line %d, column %d", t.Text, t.Line, t.Column)
+}
+
+// Int returns the value of a Number token. Another token, or a number overflowing int, panics with its position.
+func (t Token) Int() int {
+	n, err := strconv.Atoi(t.Text)
+	if t.Kind != Number || err != nil {
+		panic(fmt.Sprintf("parse: invalid integer %v", t))
+	}
+
+	return n
+}
+
+// Tokenizer splits an input into numbers, identifiers and punctuation, skipping the white space and line breaks
+// between them, for the puzzles of small expression languages. It looks one token ahead, with Peek.
+type Tokenizer struct {
+	s      string
+	offset int
+	line   int
+	column int
+
+	peeked *Token
+}
+
+// NewTokenizer returns a Tokenizer of s.
+//
+// Example:
+//
+//	tokens := parse.NewTokenizer("1 + (2 * 3)")
+//	for tokens.Peek().Kind != parse.EOF {
+//	    token := tokens.Next()
+//	    // ...
+//	}
+func NewTokenizer(s string) *Tokenizer {
+	return &Tokenizer{s: s, line: 1, column: 1}
+}
+
+// Next returns the next token and moves past it. At the end of the input, it returns an EOF token.
+func (t *Tokenizer) Next() Token {
+	if t.peeked != nil {
+		token := *t.peeked
+		t.peeked = nil
+
+		return token
+	}
+
+	return t.scan()
+}
+
+// Peek returns the next token without moving past it.
+func (t *Tokenizer) Peek() Token {
+	if t.peeked == nil {
+		token := t.scan()
+		t.peeked = &token
+	}
+
+	return *t.peeked
+}
+
+// Expect moves past the next token, and fails with ErrUnexpectedToken, wrapped with the token and its position,
+// unless its text is the expected one.
+//
+// Example:
+//
+//	if err := tokens.Expect("("); err != nil {
+//	    return err
+//	}
+func (t *Tokenizer) Expect(text string) error {
+	if token := t.Next(); token.Kind == EOF || token.Text != text {
+		return fmt.Errorf("%w: expected %q, but got %v", ErrUnexpectedToken, text, token)
+	}
+
+	return nil
+}
+
+// scan reads the token at the offset, after the white space.
+func (t *Tokenizer) scan() Token {
+	for t.offset < len(t.s) {
+		r, size := utf8.DecodeRuneInString(t.s[t.offset:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+
+		t.advance(r, size)
+	}
+
+	token := Token{Kind: EOF, Line: t.line, Column: t.column}
+	start := t.offset
+
+	if t.offset == len(t.s) {
+		return token
+	}
+
+	r, size := utf8.DecodeRuneInString(t.s[t.offset:])
+
+	switch {
+	case unicode.IsDigit(r):
+		token.Kind = Number
+		t.advanceWhile(unicode.IsDigit)
+	case r == '_' || unicode.IsLetter(r):
+		token.Kind = Ident
+		t.advanceWhile(func(r rune) bool { return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) })
+	default:
+		token.Kind = Punct
+		t.advance(r, size)
+	}
+
+	token.Text = t.s[start:t.offset]
+
+	return token
+}
+
+// advanceWhile moves past the runes for which accept is true.
+func (t *Tokenizer) advanceWhile(accept func(r rune) bool) {
+	for t.offset < len(t.s) {
+		r, size := utf8.DecodeRuneInString(t.s[t.offset:])
+		if !accept(r) {
+			return
+		}
+
+		t.advance(r, size)
+	}
+}
+
+// advance moves past r, the rune of the given size at the offset, updating the line and column.
+func (t *Tokenizer) advance(r rune, size int) {
+	t.offset += size
+
+	if r == '\n' {
+		t.line++
+		t.column = 1
+
+		return
+	}
+
+	t.column++
+}
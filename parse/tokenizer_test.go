@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package parse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hvpaiva/goaoc/parse"
+)
+
+func TestTokenizer(t *testing.T) {
+	tokens := parse.NewTokenizer("x1 = 12+(y_2)\n  jmp")
+
+	expected := []parse.Token{
+		{Kind: parse.Ident, Text: "x1", Line: 1, Column: 1},
+		{Kind: parse.Punct, Text: "=", Line: 1, Column: 4},
+		{Kind: parse.Number, Text: "12", Line: 1, Column: 6},
+		{Kind: parse.Punct, Text: "+", Line: 1, Column: 8},
+		{Kind: parse.Punct, Text: "(", Line: 1, Column: 9},
+		{Kind: parse.Ident, Text: "y_2", Line: 1, Column: 10},
+		{Kind: parse.Punct, Text: ")", Line: 1, Column: 13},
+		{Kind: parse.Ident, Text: "jmp", Line: 2, Column: 3},
+		{Kind: parse.EOF, Line: 2, Column: 6},
+		{Kind: parse.EOF, Line: 2, Column: 6},
+	}
+
+	for i, token := range expected {
+		if peeked := tokens.Peek(); peeked != token {
+			t.Errorf("Peek %d: expected %+v, but got %+v", i, token, peeked)
+		}
+
+		if next := tokens.Next(); next != token {
+			t.Errorf("Next %d: expected %+v, but got %+v", i, token, next)
+		}
+	}
+}
+
+func TestTokenizerExpect(t *testing.T) {
+	tokens := parse.NewTokenizer("(42]")
+
+	if err := tokens.Expect("("); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+
+	if n := tokens.Next().Int(); n != 42 {
+		t.Errorf("Expected 42, but got %d", n)
+	}
+
+	err := tokens.Expect(")")
+	if !errors.Is(err, parse.ErrUnexpectedToken) || err.Error() != `unexpected token: expected ")", but got "]" at line 1, column 4` {
+		t.Errorf("Expected ErrUnexpectedToken for ], but got: %v", err)
+	}
+
+	if err := tokens.Expect(""); !errors.Is(err, parse.ErrUnexpectedToken) {
+		t.Errorf("Expected ErrUnexpectedToken at the end of input, but got: %v", err)
+	}
+
+	expectPanic(t, `parse: invalid integer "x" at line 1, column 1`, func() { parse.NewTokenizer("x").Next().Int() })
+}
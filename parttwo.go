@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hvpaiva/goaoc/internal/atomicfile"
+)
+
+// errNoPartTwo indicates that the puzzle page has no description of part 2 yet.
+var errNoPartTwo = errors.New("the puzzle page has no part 2")
+
+// DescriptionPath returns the path of the description of the given part of the puzzle of the given year and day,
+// stored as Markdown next to its examples once revealed, e.g. <Dir>/2024/day07/part2.md.
+func (c InputCache) DescriptionPath(year, day int, part Part) string {
+	return filepath.Join(c.Dir, strconv.Itoa(year), fmt.Sprintf("day%02d", day), fmt.Sprintf("part%d.md", part))
+}
+
+// writePartTwo downloads the puzzle page again once part 1 is solved and stores the newly revealed description
+// of part 2, along with the examples of the page, next to the examples of the puzzle. It writes where they are
+// stored, so part 2 can be started immediately. Refreshing is a convenience, so a failure is only written.
+func (o *runOptions) writePartTwo(ctx context.Context, year, day int) {
+	path, examples, err := o.refreshPartTwo(ctx, year, day)
+
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if err != nil {
+		_, _ = fmt.Fprintf(o.stdout(), "Part 2 not refreshed: %v\n", err)
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(o.stdout(), "Part 2 unlocked: %s (%d examples in %s)\n", path, examples, filepath.Dir(path))
+}
+
+// refreshPartTwo stores the description of part 2 and the examples of the puzzle page of the given year and day
+// in the input cache, and returns the path of the description and the number of examples.
+func (o *runOptions) refreshPartTwo(ctx context.Context, year, day int) (string, int, error) {
+	cache, err := o.inputCache()
+	if err != nil {
+		return "", 0, err
+	}
+
+	page, err := o.aocProvider().page(ctx, year, day)
+	if err != nil {
+		return "", 0, err
+	}
+
+	description, ok := renderPart(page, 2)
+	if !ok {
+		return "", 0, errNoPartTwo
+	}
+
+	path := cache.DescriptionPath(year, day, 2)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", 0, err
+	}
+
+	if err := atomicfile.Write(path, []byte(description), 0o600); err != nil {
+		return "", 0, err
+	}
+
+	examples, err := cache.storePage(year, day, page)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return path, examples, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWithSubmitRefreshesPartTwo(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	solved := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			solved = true
+
+			_, _ = w.Write([]byte(answerPage))
+
+			return
+		}
+
+		page := puzzlePage
+		if solved {
+			page += `<article class="day-desc"><h2 id="part2">--- Part Two ---</h2><p>Now with <code>||</code>:</p><pre><code>6</code></pre></article>`
+		}
+
+		_, _ = w.Write([]byte(page))
+	}))
+	t.Cleanup(server.Close)
+
+	stdout := new(bytes.Buffer)
+	challenges := []ChallengeE[int]{
+		func(string) (int, error) { return 3749, nil },
+		func(string) (int, error) { return 0, nil },
+	}
+
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+	manager := DefaultConsoleManager{Env: mockEnv([]string{}, "", stdout)}
+
+	_, err := runWith(context.Background(), &opts, challenges,
+		WithManager(manager), WithPart(1), WithInputString("input"), WithYear(2024), WithDay(7), WithSubmit())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	cache, _ := opts.inputCache()
+	path := cache.DescriptionPath(2024, 7, 2)
+
+	if expected := "Part 2 unlocked: " + path + " (3 examples in "; !strings.Contains(stdout.String(), expected) {
+		t.Errorf("Expected %q in the output, but got %q", expected, stdout.String())
+	}
+
+	if description, err := os.ReadFile(path); err != nil || string(description) != "## Part Two\n\nNow with `||`:\n\n```\n6\n```\n" {
+		t.Errorf("Expected the description of part 2, but got %q (err: %v)", description, err)
+	}
+
+	if example, err := os.ReadFile(cache.ExamplePath(2024, 7, 3)); err != nil || string(example) != "6" {
+		t.Errorf("Expected the example of part 2, but got %q (err: %v)", example, err)
+	}
+}
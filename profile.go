@@ -0,0 +1,84 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// profilePattern matches the valid profile names, which are used in file names and environment variables.
+var profilePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// WithProfile creates a RunOption that selects the named profile, for solvers with several adventofcode.com
+// accounts, such as a personal one and one for a work leaderboard. Each profile has its own session, see
+// ResolveProfileSession and SetProfileSession, and its own input cache, in the profiles/<profile> directory
+// of the cache, so inputs of different accounts are never mixed up.
+//
+// Without WithProfile, the profile is taken from the -profile flag of the console manager, or else from the
+// GOAOC_PROFILE environment variable. The default profile, with an empty name, uses the default session and cache.
+// A name made of other characters than letters, digits, dashes and underscores fails with ErrInvalidProfile.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 7), WithProfile("work"))
+func WithProfile(profile string) RunOption {
+	return func(options *runOptions) error {
+		if err := validateProfile(profile); err != nil {
+			return err
+		}
+
+		options.profile = profile
+
+		return nil
+	}
+}
+
+// validateProfile returns ErrInvalidProfile if profile is not a valid profile name. The default profile is valid.
+func validateProfile(profile string) error {
+	if profile != "" && !profilePattern.MatchString(profile) {
+		return fmt.Errorf("%w: %q", ErrInvalidProfile, profile)
+	}
+
+	return nil
+}
+
+// resolveProfile sets the profile, when not set with WithProfile, from the -profile flag or the GOAOC_PROFILE
+// environment variable.
+func (o *runOptions) resolveProfile() error {
+	if o.profile != "" {
+		return nil
+	}
+
+	profile, err := profileInConsole(o.reader)
+	if err != nil {
+		return err
+	}
+
+	if profile == "" {
+		profile = os.Getenv("GOAOC_PROFILE")
+	}
+
+	if err := validateProfile(profile); err != nil {
+		return err
+	}
+
+	o.profile = profile
+
+	return nil
+}
+
+// profileInConsole returns the value of the -profile flag, when reader is a DefaultConsoleManager.
+func profileInConsole(reader InputReader) (string, error) {
+	switch console := reader.(type) {
+	case DefaultConsoleManager:
+		return getProfileInFlag(console.Env)
+	case *DefaultConsoleManager:
+		return getProfileInFlag(console.Env)
+	default:
+		return "", nil
+	}
+}
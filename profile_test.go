@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetProfileInFlag(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		expected  string
+		expectErr bool
+	}{
+		{"NotSet", []string{"-part=1"}, "", false},
+		{"Value", []string{"-profile=work", "-part=1"}, "work", false},
+		{"NextArgument", []string{"-part", "1", "--profile", "work"}, "work", false},
+		{"AfterTerminator", []string{"--", "-profile=work"}, "", false},
+		{"MissingValue", []string{"-profile"}, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			profile, err := getProfileInFlag(Env{Args: tc.args})
+			if (err != nil) != tc.expectErr || profile != tc.expected {
+				t.Errorf("Expected %q (error: %v), but got %q (err: %v)", tc.expected, tc.expectErr, profile, err)
+			}
+		})
+	}
+
+	if flags, err := parseFlags(Env{Args: []string{"-part=1", "-profile", "work"}}); err != nil || flags.part != "1" {
+		t.Errorf("Expected the part flag to be parsed along the profile flag, but got %q (err: %v)", flags.part, err)
+	}
+}
+
+func TestResolveProfile(t *testing.T) {
+	t.Setenv("GOAOC_PROFILE", "env")
+
+	testCases := []struct {
+		name      string
+		options   []RunOption
+		args      []string
+		expected  string
+		expectErr error
+	}{
+		{"Environment", nil, []string{"-part=1"}, "env", nil},
+		{"Flag", nil, []string{"-part=1", "-profile=flag"}, "flag", nil},
+		{"Option", []RunOption{WithProfile("option")}, []string{"-part=1", "-profile=flag"}, "option", nil},
+		{"InvalidOption", []RunOption{WithProfile("../work")}, []string{"-part=1"}, "", ErrInvalidProfile},
+		{"InvalidFlag", nil, []string{"-part=1", "-profile=a b"}, "", ErrInvalidProfile},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := runOptions{reader: DefaultConsoleManager{Env: Env{Args: tc.args}}}
+
+			err := injectOptions(&opts, 2, tc.options...)
+			if !errors.Is(err, tc.expectErr) || opts.profile != tc.expected {
+				t.Errorf("Expected profile %q (error: %v), but got %q (err: %v)", tc.expected, tc.expectErr, opts.profile, err)
+			}
+		})
+	}
+}
+
+func TestProfileSessionAndCache(t *testing.T) {
+	config := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", config)
+	t.Setenv("HOME", config)
+	t.Setenv("AOC_SESSION", "personal")
+	t.Setenv("GOAOC_SESSION_WORK_BOARD", "")
+
+	path, err := sessionFile("work-board")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	writeFile(t, path, "from file\n")
+
+	if session, source, err := ResolveProfileSession("work-board"); err != nil || session != "from file" || source != path+" file" {
+		t.Errorf("Expected the session of the profile file, but got %q from %q (err: %v)", session, source, err)
+	}
+
+	t.Setenv("GOAOC_SESSION_WORK_BOARD", "from env")
+
+	if session, _, err := ResolveProfileSession("work-board"); err != nil || session != "from env" {
+		t.Errorf("Expected the session of GOAOC_SESSION_WORK_BOARD, but got %q (err: %v)", session, err)
+	}
+
+	dir := t.TempDir()
+	personal := runOptions{cacheDir: dir}
+	work := runOptions{cacheDir: dir, profile: "work-board"}
+
+	personalCache, err := personal.inputCache()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	workCache, err := work.inputCache()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if workCache.Dir != filepath.Join(dir, "profiles", "work-board") || workCache.Path(2024, 7) == personalCache.Path(2024, 7) {
+		t.Errorf("Expected the profile to have its own cache, but got %s and %s", workCache.Dir, personalCache.Dir)
+	}
+
+	if workCache.Session != SessionID("from env") || personalCache.Session != SessionID("personal") {
+		t.Error("Expected each cache to be bound to the session of its profile")
+	}
+}
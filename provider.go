@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+)
+
+// InputProvider is an interface that abstracts where the input of a puzzle comes from.
+// Implementations fetch the input of the given year and day from a source such as files, an embedded
+// file system, adventofcode.com or any custom storage (S3, a database, ...), and can be composed,
+// e.g. with CachedProvider and ChainProviders.
+type InputProvider interface {
+	// Fetch returns the input of the puzzle of the given year and day, giving up when ctx is done, e.g. when
+	// the context of RunContext is cancelled.
+	// Errors may result from issues such as a missing input or a failed download.
+	// Example:
+	//   input, err := provider.Fetch(ctx, 2024, 7)
+	//   if err != nil {
+	//       log.Println("Failed to fetch input:", err)
+	//   }
+	Fetch(ctx context.Context, year, day int) (string, error)
+}
+
+// InputProviderFunc is an adapter to allow the use of ordinary functions as InputProvider.
+type InputProviderFunc func(ctx context.Context, year, day int) (string, error)
+
+// Fetch calls f(ctx, year, day).
+func (f InputProviderFunc) Fetch(ctx context.Context, year, day int) (string, error) {
+	return f(ctx, year, day)
+}
+
+// FileProvider returns an InputProvider that reads the input from the file whose path is given by pattern,
+// formatted with the year and the day, e.g. "inputs/%d/day%02d.txt". The file is read and trimmed like with
+// WithInputFile.
+func FileProvider(pattern string) InputProvider {
+	return InputProviderFunc(func(_ context.Context, year, day int) (string, error) {
+		return fileInput(os.ReadFile(fmt.Sprintf(pattern, year, day)))
+	})
+}
+
+// FSProvider returns an InputProvider that reads the input from the file of fsys whose path is given by pattern,
+// formatted with the year and the day, like FileProvider. Use it with an embed.FS to embed the inputs.
+func FSProvider(fsys fs.FS, pattern string) InputProvider {
+	return InputProviderFunc(func(_ context.Context, year, day int) (string, error) {
+		return fileInput(fs.ReadFile(fsys, fmt.Sprintf(pattern, year, day)))
+	})
+}
+
+// HTTPProvider returns an InputProvider that downloads the input from the URL given by pattern, formatted
+// with the year and the day, sending the given headers. It is used like WithInputURL, e.g. for a mirror.
+func HTTPProvider(pattern string, header http.Header) InputProvider {
+	return InputProviderFunc(func(ctx context.Context, year, day int) (string, error) {
+		return fetch(ctx, nil, fmt.Sprintf(pattern, year, day), header)
+	})
+}
+
+// CachedProvider returns an InputProvider that returns the inputs stored in cache, fetching them from
+// provider and storing them in cache when they are not cached yet, when they are stale (see InputCache.Fresh),
+// or when they are corrupted (see InputCache.Load).
+func CachedProvider(cache InputCache, provider InputProvider) InputProvider {
+	return InputProviderFunc(func(ctx context.Context, year, day int) (string, error) {
+		input, ok, err := cache.Load(year, day)
+		if err != nil && !errors.Is(err, ErrCorruptedCache) {
+			return "", err
+		}
+
+		if ok {
+			fresh, err := cache.Fresh(year, day)
+			if err != nil || fresh {
+				return input, err
+			}
+		}
+
+		if input, err = provider.Fetch(ctx, year, day); err != nil {
+			return "", err
+		}
+
+		if err := cache.Store(year, day, input); err != nil {
+			return "", err
+		}
+
+		return input, cache.recordFetch(year, day)
+	})
+}
+
+// ChainProviders returns an InputProvider that tries each provider in order, returning the first input
+// fetched. If every provider fails, their errors are joined.
+//
+// Example:
+//
+//	provider := ChainProviders(FileProvider("inputs/day%02[2]d.txt"), CachedProvider(cache, AoCProvider()))
+func ChainProviders(providers ...InputProvider) InputProvider {
+	return InputProviderFunc(func(ctx context.Context, year, day int) (string, error) {
+		errs := make([]error, 0, len(providers))
+
+		for _, provider := range providers {
+			input, err := provider.Fetch(ctx, year, day)
+			if err == nil {
+				return input, nil
+			}
+
+			errs = append(errs, err)
+		}
+
+		return "", errors.Join(errs...)
+	})
+}
+
+// WithInputProvider creates a RunOption that takes the challenge input of the given year and day from provider.
+// The input is fetched only after the part has been resolved, like with WithInputFunc, with the context of the
+// run, so cancelling the context of RunContext cancels the fetch. A zero year or day is
+// taken from WithYear and WithDay, or inferred from the directory names, failing with ErrUnknownPuzzle if unknown.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputProvider(FSProvider(inputs, "day%02[2]d.txt"), 2024, 7))
+func WithInputProvider(provider InputProvider, year, day int) RunOption {
+	return func(options *runOptions) error {
+		options.setPuzzle(year, day)
+		options.input = func(ctx context.Context) (string, error) {
+			year, day, err := options.puzzle(year, day)
+			if err != nil {
+				return "", err
+			}
+
+			return provider.Fetch(ctx, year, day)
+		}
+		options.inputPath = ""
+
+		return nil
+	}
+}
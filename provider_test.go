@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/hvpaiva/goaoc"
+	"github.com/hvpaiva/goaoc/mock"
+)
+
+func TestInputProviders(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "2024-07.txt"), []byte("from file\n"), 0o600); err != nil {
+		t.Fatalf("Unexpected error writing input file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2024/7" || r.Header.Get("X-Token") != "token" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write([]byte("from http"))
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := []struct {
+		name     string
+		provider goaoc.InputProvider
+		expected string
+	}{
+		{"File", goaoc.FileProvider(filepath.Join(dir, "%d-%02d.txt")), "from file"},
+		{"FS", goaoc.FSProvider(fstest.MapFS{"day07.txt": {Data: []byte("from fs")}}, "day%02[2]d.txt"), "from fs"},
+		{"HTTP", goaoc.HTTPProvider(server.URL+"/%d/%d", http.Header{"X-Token": {"token"}}), "from http"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input, err := tc.provider.Fetch(context.Background(), 2024, 7)
+			if err != nil || input != tc.expected {
+				t.Errorf("Expected input '%s', but got %q (err: %v)", tc.expected, input, err)
+			}
+		})
+	}
+}
+
+func TestCachedProvider(t *testing.T) {
+	fetches := 0
+	provider := goaoc.InputProviderFunc(func(_ context.Context, _, _ int) (string, error) {
+		fetches++
+
+		return "fetched", nil
+	})
+
+	cache := goaoc.InputCache{Dir: t.TempDir()}
+	cached := goaoc.CachedProvider(cache, provider)
+
+	for range 2 {
+		if input, err := cached.Fetch(context.Background(), 2024, 7); err != nil || input != "fetched" {
+			t.Fatalf("Expected input 'fetched', but got %q (err: %v)", input, err)
+		}
+	}
+
+	if fetches != 1 {
+		t.Errorf("Expected one fetch, but got %d", fetches)
+	}
+}
+
+func TestChainProviders(t *testing.T) {
+	errFirst := errors.New("first failed")
+	errSecond := errors.New("second failed")
+	failing := func(err error) goaoc.InputProvider {
+		return goaoc.InputProviderFunc(func(_ context.Context, _, _ int) (string, error) { return "", err })
+	}
+	succeeding := goaoc.InputProviderFunc(func(_ context.Context, _, _ int) (string, error) { return "found", nil })
+
+	input, err := goaoc.ChainProviders(failing(errFirst), succeeding, failing(errSecond)).Fetch(context.Background(), 2024, 7)
+	if err != nil || input != "found" {
+		t.Errorf("Expected input 'found', but got %q (err: %v)", input, err)
+	}
+
+	_, err = goaoc.ChainProviders(failing(errFirst), failing(errSecond)).Fetch(context.Background(), 2024, 7)
+	if !errors.Is(err, errFirst) || !errors.Is(err, errSecond) {
+		t.Errorf("Expected the errors of every provider, but got: %v", err)
+	}
+}
+
+func TestSolveWithInputProvider(t *testing.T) {
+	provider := goaoc.InputProviderFunc(func(_ context.Context, year, day int) (string, error) {
+		if year != 2024 || day != 7 {
+			return "", goaoc.ErrInputFileNotFound
+		}
+
+		return "provided", nil
+	})
+
+	mok := mock.NewManager("1", nil, nil)
+	err := goaoc.Solve(echoPart, echoPart, goaoc.WithManager(&mok), goaoc.WithInputProvider(provider, 2024, 7))
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if expected := "The challenge result is provided\n"; mok.GetStdout() != expected {
+		t.Errorf("Expected output '%s', but got '%s'", expected, mok.GetStdout())
+	}
+}
+
+func TestInputProviderCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	provider := goaoc.InputProviderFunc(func(ctx context.Context, _, _ int) (string, error) {
+		cancel()
+		<-ctx.Done()
+
+		return "", ctx.Err()
+	})
+
+	mok := mock.NewManager("1", nil, nil)
+	err := goaoc.RunContext(ctx, "ignored", echoPart, echoPart, goaoc.WithManager(&mok), goaoc.WithInputProvider(provider, 2024, 7))
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the fetch to be cancelled, but got: %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/hvpaiva/goaoc/internal/buildinfo"
+)
+
+var (
+	// dayPattern matches the name of a directory holding the solution of a day, such as day07 or day-7.
+	dayPattern = regexp.MustCompile(`(?i)^day[-_]?(\d{1,2})$`)
+
+	// yearPattern matches the name of a directory holding the solutions of a year, such as 2024 or aoc2024.
+	yearPattern = regexp.MustCompile(`(?i)^(?:aoc[-_]?)?(\d{4})$`)
+)
+
+// WithYear creates a RunOption that sets the year of the puzzle, used by the input options given a zero year,
+// such as WithInputDownload(0, 0), and to locate the sample input. A year before 2015, the first Advent of Code,
+// fails at option time with ErrInvalidYear.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithYear(2024), WithDay(7), WithInputDownload(0, 0))
+func WithYear(year int) RunOption {
+	return func(options *runOptions) error {
+		if year < 2015 {
+			return fmt.Errorf("%w: %d", ErrInvalidYear, year)
+		}
+
+		options.year = year
+
+		return nil
+	}
+}
+
+// WithDay creates a RunOption that sets the day of the puzzle, used by the input options given a zero day,
+// such as WithInputDownload(0, 0), and to locate the sample input. A day out of 1 to 25 fails at option
+// time with ErrInvalidDay.
+//
+// Without WithYear and WithDay, they are inferred from the directory of the package calling goaoc, or else
+// the working directory: a day<DD> directory, such as day07, gives the day, and a <year> or aoc<year>
+// directory containing it, such as 2024, gives the year.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithYear(2024), WithDay(7), WithInputDownload(0, 0))
+func WithDay(day int) RunOption {
+	return func(options *runOptions) error {
+		if day < 1 || day > 25 {
+			return fmt.Errorf("%w: %d", ErrInvalidDay, day)
+		}
+
+		options.day = day
+
+		return nil
+	}
+}
+
+// setPuzzle records the year and day given to an input option, keeping the configured ones when zero.
+func (o *runOptions) setPuzzle(year, day int) {
+	if year != 0 {
+		o.year = year
+	}
+
+	if day != 0 {
+		o.day = day
+	}
+}
+
+// puzzle returns the given year and day, taking zero values from the configured or inferred ones.
+// It fails with ErrUnknownPuzzle if either is still unknown.
+func (o *runOptions) puzzle(year, day int) (int, int, error) {
+	if year == 0 {
+		year = o.year
+	}
+
+	if day == 0 {
+		day = o.day
+	}
+
+	if year == 0 || day == 0 {
+		return 0, 0, fmt.Errorf("%w: got year %d and day %d", ErrUnknownPuzzle, year, day)
+	}
+
+	return year, day, nil
+}
+
+// inferPuzzle sets the year and day not configured yet from the directory names of the package calling
+// goaoc, or else of the working directory.
+func (o *runOptions) inferPuzzle() {
+	for _, dir := range puzzleDirs() {
+		if o.year != 0 && o.day != 0 {
+			return
+		}
+
+		year, day := parsePuzzleDir(dir)
+		if o.day == 0 && day != 0 {
+			o.day = day
+		}
+
+		if o.year == 0 && year != 0 {
+			o.year = year
+		}
+	}
+}
+
+// puzzleDirs returns the directory of the first caller outside goaoc, followed by the working directory.
+func puzzleDirs() []string {
+	var dirs []string
+
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, buildinfo.ModulePath+".") && !strings.HasPrefix(frame.Function, "runtime.") && frame.File != "" {
+			dirs = append(dirs, filepath.Dir(frame.File))
+
+			break
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	if dir, err := os.Getwd(); err == nil {
+		dirs = append(dirs, dir)
+	}
+
+	return dirs
+}
+
+// parsePuzzleDir returns the day given by the name of dir, and the year given by the nearest of its
+// ancestors named after a year, or zero when there is none.
+func parsePuzzleDir(dir string) (year, day int) {
+	if match := dayPattern.FindStringSubmatch(filepath.Base(dir)); match != nil {
+		if day, _ = strconv.Atoi(match[1]); day > 25 {
+			day = 0
+		}
+	}
+
+	for ; dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+		if match := yearPattern.FindStringSubmatch(filepath.Base(dir)); match != nil {
+			if year, _ = strconv.Atoi(match[1]); year >= 2015 {
+				return year, day
+			}
+		}
+	}
+
+	return 0, day
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestParsePuzzleDir(t *testing.T) {
+	testCases := []struct {
+		dir          string
+		expectedYear int
+		expectedDay  int
+	}{
+		{filepath.Join("/", "src", "aoc", "2024", "day07"), 2024, 7},
+		{filepath.Join("/", "src", "aoc2023", "solutions", "day-12"), 2023, 12},
+		{filepath.Join("/", "src", "2024", "Day_3"), 2024, 3},
+		{filepath.Join("/", "src", "aoc", "day26"), 0, 0},
+		{filepath.Join("/", "src", "1999", "day01"), 0, 1},
+		{filepath.Join("/", "src", "module"), 0, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.dir, func(t *testing.T) {
+			if year, day := parsePuzzleDir(tc.dir); year != tc.expectedYear || day != tc.expectedDay {
+				t.Errorf("Expected year %d and day %d, but got %d and %d", tc.expectedYear, tc.expectedDay, year, day)
+			}
+		})
+	}
+}
+
+func TestWithYearAndDay(t *testing.T) {
+	var opts runOptions
+
+	if err := WithYear(2014)(&opts); !errors.Is(err, ErrInvalidYear) {
+		t.Errorf("Expected ErrInvalidYear, but got: %v", err)
+	}
+
+	if err := WithDay(26)(&opts); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("Expected ErrInvalidDay, but got: %v", err)
+	}
+
+	if _, _, err := opts.puzzle(0, 7); !errors.Is(err, ErrUnknownPuzzle) {
+		t.Errorf("Expected ErrUnknownPuzzle, but got: %v", err)
+	}
+
+	var fetched string
+
+	provider := InputProviderFunc(func(_ context.Context, year, day int) (string, error) {
+		fetched = fmt.Sprintf("%d/%d", year, day)
+
+		return "input", nil
+	})
+
+	for _, option := range []RunOption{WithYear(2024), WithDay(7), WithInputProvider(provider, 0, 0)} {
+		if err := option(&opts); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if _, err := opts.input(context.Background()); err != nil || fetched != "2024/7" {
+		t.Errorf("Expected the input of 2024/7, but got %q (err: %v)", fetched, err)
+	}
+
+	if err := WithInputProvider(provider, 2023, 0)(&opts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := opts.input(context.Background()); err != nil || fetched != "2023/7" {
+		t.Errorf("Expected the input of 2023/7, but got %q (err: %v)", fetched, err)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+// PartResolver is an interface that abstracts how the DefaultConsoleManager finds out which part to run.
+// Resolvers can be composed with ChainPartResolvers, so sources can be reordered, dropped (e.g. the
+// interactive prompt in CI) or extended with custom ones, such as a config file.
+type PartResolver interface {
+	// ResolvePart returns the part to run, as read from its source, using env for I/O and environment access.
+	// An empty part with a nil error means the source did not provide a part, so the next resolver may be tried.
+	// Example:
+	//   part, err := FlagPartResolver().ResolvePart(env)
+	//   if err != nil {
+	//       log.Println("Failed to resolve part:", err)
+	//   }
+	ResolvePart(env Env) (string, error)
+}
+
+// PartResolverFunc is an adapter to allow the use of ordinary functions as a PartResolver.
+//
+// Example:
+//
+//	fromConfig := PartResolverFunc(func(env Env) (string, error) {
+//	    return config.Part, nil
+//	})
+type PartResolverFunc func(env Env) (string, error)
+
+// ResolvePart calls f(env).
+func (f PartResolverFunc) ResolvePart(env Env) (string, error) {
+	return f(env)
+}
+
+// FlagPartResolver returns a PartResolver that reads the part from the -part flag in Env.Args.
+func FlagPartResolver() PartResolver {
+	return PartResolverFunc(getPartInFlag)
+}
+
+// EnvPartResolver returns a PartResolver that reads the part from the GOAOC_CHALLENGE_PART environment variable.
+func EnvPartResolver() PartResolver {
+	return PartResolverFunc(getPartInEnv)
+}
+
+// PromptPartResolver returns a PartResolver that interactively asks for the part on Env.Stdout,
+// reading the answer from Env.Stdin.
+func PromptPartResolver() PartResolver {
+	return PartResolverFunc(getPartInStdin)
+}
+
+// ChainPartResolvers returns a PartResolver that tries each resolver in order, returning the first part found.
+// The first error aborts the chain.
+//
+// Example:
+//
+//	resolver := ChainPartResolvers(EnvPartResolver(), FlagPartResolver())
+func ChainPartResolvers(resolvers ...PartResolver) PartResolver {
+	return PartResolverFunc(func(env Env) (string, error) {
+		for _, resolver := range resolvers {
+			part, err := resolver.ResolvePart(env)
+			if err != nil {
+				return "", err
+			}
+
+			if part != "" {
+				return part, nil
+			}
+		}
+
+		return "", nil
+	})
+}
+
+// DefaultPartResolver returns the resolver used by the DefaultConsoleManager when none is set:
+// the -part flag, then the GOAOC_CHALLENGE_PART environment variable, then the interactive prompt.
+func DefaultPartResolver() PartResolver {
+	return ChainPartResolvers(FlagPartResolver(), EnvPartResolver(), PromptPartResolver())
+}
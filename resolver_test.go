@@ -0,0 +1,89 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestChainPartResolvers(t *testing.T) {
+	errConfig := errors.New("config unreadable")
+	vars := map[string]string{"GOAOC_CHALLENGE_PART": "2"}
+
+	testCases := []struct {
+		name      string
+		resolver  PartResolver
+		expect    string
+		expectErr string
+	}{
+		{"EnvBeforeFlag", ChainPartResolvers(EnvPartResolver(), FlagPartResolver()), "2", ""},
+		{"FlagBeforeEnv", ChainPartResolvers(FlagPartResolver(), EnvPartResolver()), "1", ""},
+		{"CustomResolver", ChainPartResolvers(PartResolverFunc(func(_ Env) (string, error) { return "3", nil }), FlagPartResolver()), "3", ""},
+		{"SkipsEmpty", ChainPartResolvers(PartResolverFunc(func(_ Env) (string, error) { return "", nil }), EnvPartResolver()), "2", ""},
+		{"ErrorAborts", ChainPartResolvers(PartResolverFunc(func(_ Env) (string, error) { return "", errConfig }), EnvPartResolver()), "", "config unreadable"},
+		{"Empty", ChainPartResolvers(), "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := mockEnv([]string{"-part=1"}, "", new(bytes.Buffer))
+			env.EnvLookup = func(key string) string { return vars[key] }
+
+			part, err := tc.resolver.ResolvePart(env)
+			if tc.expectErr != "" {
+				if err == nil || err.Error() != tc.expectErr {
+					t.Fatalf("Expected error '%s', but got: %v", tc.expectErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if part != tc.expect {
+				t.Errorf("Expected part %q, but got %q", tc.expect, part)
+			}
+		})
+	}
+}
+
+func TestReadWithoutPrompt(t *testing.T) {
+	t.Parallel()
+
+	stdout := new(bytes.Buffer)
+	env := mockEnv([]string{}, "1\n", stdout)
+	env.EnvLookup = func(_ string) string { return "" }
+	manager := DefaultConsoleManager{Env: env, Resolver: ChainPartResolvers(FlagPartResolver(), EnvPartResolver())}
+
+	_, err := manager.Read("part")
+	if !errors.Is(err, ErrMissingPart) {
+		t.Fatalf("Expected ErrMissingPart, but got: %v", err)
+	}
+
+	if stdout.Len() != 0 {
+		t.Errorf("Expected no prompt, but got '%s'", stdout.String())
+	}
+}
+
+func TestWithPartResolver(t *testing.T) {
+	t.Parallel()
+
+	opts := runOptions{reader: DefaultConsoleManager{Env: mockEnv([]string{"-part=1"}, "", new(bytes.Buffer))}}
+
+	err := injectOptions(&opts, 2, WithPartResolver(PartResolverFunc(func(_ Env) (string, error) { return "2", nil })))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if opts.part != 2 {
+		t.Errorf("Expected part 2 from the custom resolver, but got %d", opts.part)
+	}
+}
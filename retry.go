@@ -0,0 +1,33 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"time"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+// WithDownloadRetries creates a RunOption that retries failed input downloads, such as WithInputDownload and
+// WithInputURL, up to retries times, e.g. to survive the server hiccups of December 1st. Only transient failures
+// are retried: 5xx responses and timeouts, never 4xx responses. The delay before the n-th retry grows
+// exponentially from backoff, as backoff * 2^(n-1), up to a minute, with a random jitter of up to half of it
+// so concurrent runs do not retry in lockstep, and a zero backoff retries immediately. A negative number of
+// retries fails with ErrNegativeRetries.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 1), WithDownloadRetries(3, time.Second))
+func WithDownloadRetries(retries int, backoff time.Duration) RunOption {
+	return func(options *runOptions) error {
+		if retries < 0 {
+			return ErrNegativeRetries
+		}
+
+		options.download = aocapi.RetryPolicy{Retries: retries, Backoff: backoff}
+
+		return nil
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithDownloadRetries(t *testing.T) {
+	testCases := []struct {
+		name             string
+		statuses         []int
+		retries          int
+		expectedRequests int
+		expectErr        bool
+	}{
+		{"ServerErrorsRetried", []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK}, 3, 3, false},
+		{"RetriesExhausted", []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK}, 1, 2, true},
+		{"ClientErrorNotRetried", []int{http.StatusBadRequest, http.StatusOK}, 3, 1, true},
+		{"NoRetries", []int{http.StatusServiceUnavailable, http.StatusOK}, 0, 1, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.statuses[requests])
+				requests++
+			}))
+			t.Cleanup(server.Close)
+
+			var opts runOptions
+			for _, option := range []RunOption{WithDownloadRetries(tc.retries, time.Millisecond), WithInputURL(server.URL, nil)} {
+				if err := option(&opts); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			_, err := opts.input(context.Background())
+			if (err != nil) != tc.expectErr || requests != tc.expectedRequests {
+				t.Errorf("Expected %d requests (error: %v), but got %d (err: %v)", tc.expectedRequests, tc.expectErr, requests, err)
+			}
+
+			if err != nil && !errors.Is(err, ErrDownloadFailed) {
+				t.Errorf("Expected ErrDownloadFailed, but got: %v", err)
+			}
+		})
+	}
+
+	if err := WithDownloadRetries(-1, time.Second)(&runOptions{}); !errors.Is(err, ErrNegativeRetries) {
+		t.Errorf("Expected ErrNegativeRetries, but got: %v", err)
+	}
+}
@@ -29,46 +29,134 @@
 package goaoc
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"runtime/debug"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+	"github.com/hvpaiva/goaoc/parse"
 )
 
 // runOptions holds the configurations needed for running a challenge.
-// It includes the IOManager for handling input/output and the challenge Part.
+// It includes the InputReader and OutputWriter for handling input/output and the challenge Part.
 type runOptions struct {
-	manager IOManager
-	part    Part
+	reader      InputReader
+	writer      OutputWriter
+	part        Part
+	allParts    bool
+	sequential  bool
+	timeout     time.Duration
+	memoryLimit uint64
+	noRecover   bool
+	input       func(ctx context.Context) (string, error)
+	inputPath   string
+	namedInputs []namedInput
+	sample      bool
+	stream      bool
+	year        int
+	day         int
+	normalizers []InputNormalizer
+	cacheDir    string
+	baseURL     string
+	contact     string
+	userAgent   string
+	session     string
+	profile     string
+	download    aocapi.RetryPolicy
+	httpClient  *http.Client
+	client      aocapi.Client
+	seed        *int64
+	watch       bool
+	waitUnlock  bool
+	notifier    Notifier
+	notifyAfter time.Duration
+	submitMode  SubmitMode
+	submitted   map[submittedAnswer]bool
+	describe    bool
+	verify      bool
+	offline     bool
+	metadata    io.Writer
+	retries     int
+	accept      func(answer any) (bool, error)
+	validators  []func(answer any) error
+	formatter   func(answer any) (string, error)
+	console     []func(manager *DefaultConsoleManager)
+	beforeRun   []func(part Part, input string)
+	afterRun    []func(result any) error
+	onError     []func(err error)
+}
+
+// selectedParts returns the parts to be executed, in order, out of the given number of parts.
+func (o runOptions) selectedParts(count int) []Part {
+	if !o.allParts {
+		return []Part{o.part}
+	}
+
+	parts := make([]Part, count)
+	for i := range parts {
+		parts[i] = Part(i + 1)
+	}
+
+	return parts
 }
 
 // RunOption is a functional option type for configuring runOptions.
 // It allows the user to customize aspects of the Run function.
 type RunOption func(options *runOptions) error
 
-// IOManager is an interface that abstracts the process of reading and writing data.
-// It allows for different implementations to manage input and output according to varying needs, such as
-// console-based, file-based, or even network-based I/O.
-type IOManager interface {
-	// Write writes the result string to an output destination.
-	// Implementations must handle errors that occur during the write operation, such as IO errors.
-	// Example:
-	//   err := manager.Write("result data")
-	//   if err != nil {
-	//       log.Println("Failed to write result:", err)
-	//   }
-	Write(result string) error
-
+// InputReader is an interface that abstracts the process of reading run arguments.
+// It allows for different implementations to source arguments according to varying needs, such as
+// command-line flags, environment variables or an interactive prompt.
+type InputReader interface {
 	// Read retrieves a value based on the given argument string.
 	// It's typically used to fetch configuration settings like which part of a challenge to run.
 	// Errors may result from issues such as missing data or failed parse attempts.
 	// Example:
-	//   arg, err := manager.Read("part")
+	//   arg, err := reader.Read("part")
 	//   if err != nil {
 	//       log.Println("Failed to read argument:", err)
 	//   }
 	Read(arg string) (string, error)
 }
 
+// OutputWriter is an interface that abstracts the process of writing challenge answers.
+// It allows for different implementations to output answers according to varying needs, such as
+// console-based, file-based, or even network-based output.
+type OutputWriter interface {
+	// Write writes the result string to an output destination.
+	// The result is the challenge answer rendered as text. String answers are passed as they are,
+	// so the result may span multiple lines.
+	// Implementations must handle errors that occur during the write operation, such as IO errors.
+	// Example:
+	//   err := writer.Write("result data")
+	//   if err != nil {
+	//       log.Println("Failed to write result:", err)
+	//   }
+	Write(result string) error
+}
+
+// IOManager is an interface that abstracts the process of reading and writing data.
+// It combines an InputReader and an OutputWriter, allowing for different implementations to manage input
+// and output according to varying needs, such as console-based, file-based, or even network-based I/O.
+// Use WithReader or WithWriter to replace only one side of the default console manager.
+type IOManager interface {
+	OutputWriter
+	InputReader
+}
+
 // Run executes given Challenge functions partOne and partTwo, based on the input provided
-// and optional configurations. It writes output via the configured IOManager.
+// and optional configurations. It writes output via the configured OutputWriter.
+// The answer type T is inferred from the challenge functions, so both parts must return the same type.
+// An input option, such as WithInputFile, takes precedence over the input argument; see Solve.
+// partTwo may be nil while part 2 is not written yet, in which case running it returns ErrPartNotImplemented.
 //
 // Example:
 //
@@ -78,24 +166,353 @@ type IOManager interface {
 //	}
 //
 // By default, output is written to the console, but you can change this by providing different IOManagers.
+// Run and its variants are safe to call from multiple goroutines, e.g. to run many days at once: each call
+// has its own options, and the default console manager serializes its access to the console.
 //
 // Possible errors include option injection failures, I/O errors, and invalid part errors.
-func Run(input string, partOne, partTwo Challenge, options ...RunOption) error {
+func Run[T comparable](input string, partOne, partTwo Challenge[T], options ...RunOption) error {
+	_, err := run(context.Background(), withErrors(partOne, partTwo), withInput(input, options)...)
+
+	return err
+}
+
+// RunE works like Run, but accepts ChallengeE functions, which may return an error.
+// An error returned by the executed part is wrapped in a ChallengeError and returned, and no output is written.
+//
+// Example:
+//
+//	err := RunE(inputData, func(input string) (int, error) { return strconv.Atoi(input) }, part2Func, WithPart(1))
+//	var challengeErr ChallengeError
+//	if errors.As(err, &challengeErr) {
+//	    log.Fatalf("part %d could not be solved: %v", challengeErr.Part, challengeErr.Err)
+//	}
+func RunE[T comparable](input string, partOne, partTwo ChallengeE[T], options ...RunOption) error {
+	_, err := run(context.Background(), []ChallengeE[T]{partOne, partTwo}, withInput(input, options)...)
+
+	return err
+}
+
+// RunContext works like Run, but aborts the execution when ctx is cancelled or its deadline expires,
+// returning ctx.Err(). This allows long brute-force solutions to be interrupted by callers and tests.
+//
+// Since a Challenge does not receive the context, an interrupted challenge keeps running in its own
+// goroutine until it returns, but its result is discarded and nothing is written to the OutputWriter.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//	defer cancel()
+//
+//	err := RunContext(ctx, inputData, part1Func, part2Func, WithPart(2))
+//	if errors.Is(err, context.DeadlineExceeded) {
+//	    log.Fatal("part 2 is still too slow")
+//	}
+func RunContext[T comparable](ctx context.Context, input string, partOne, partTwo Challenge[T], options ...RunOption) error {
+	_, err := run(ctx, withErrors(partOne, partTwo), withInput(input, options)...)
+
+	return err
+}
+
+// RunResult works like Run, but also returns a Result with the computed answer, the executed part and
+// the execution duration, so programs embedding goaoc can post-process the answer.
+// The answer is still written via the configured OutputWriter.
+// When both parts are executed, the Result of part 2 is returned; use RunResults to get both.
+//
+// Example:
+//
+//	result, err := RunResult(inputData, part1Func, part2Func, WithPart(1))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	log.Printf("part %d took %s", result.Part, result.Duration)
+func RunResult[T comparable](input string, partOne, partTwo Challenge[T], options ...RunOption) (Result[T], error) {
+	results, err := run(context.Background(), withErrors(partOne, partTwo), withInput(input, options)...)
+	if len(results) == 0 {
+		return Result[T]{}, err
+	}
+
+	return results[len(results)-1], err
+}
+
+// RunResults works like RunResult, but returns one Result per executed part, in part order.
+// It is mostly useful together with WithBothParts.
+//
+// Example:
+//
+//	results, err := RunResults(inputData, part1Func, part2Func, WithBothParts())
+func RunResults[T comparable](input string, partOne, partTwo Challenge[T], options ...RunOption) ([]Result[T], error) {
+	return run(context.Background(), withErrors(partOne, partTwo), withInput(input, options)...)
+}
+
+// RunSolver works like Run, but takes both parts from a Solver instead of two free functions.
+// When both parts run concurrently, Part1 and Part2 may be called at the same time; use WithSequential
+// if they mutate state shared through the Solver.
+//
+// Example:
+//
+//	err := RunSolver(inputData, day07{}, WithPart(1))
+func RunSolver[T comparable](input string, solver Solver[T], options ...RunOption) error {
+	_, err := run(context.Background(), withErrors[T](solver.Part1, solver.Part2), withInput(input, options)...)
+
+	return err
+}
+
+// RunParsed works like RunE, but parses the input only once with parse and hands the parsed value
+// to both parts, instead of each part re-parsing the raw string. The parsing is done lazily by the
+// first part executed, so its cost is included in that part's duration. Each input is parsed on its own,
+// so the inputs registered with WithNamedInput and the inputs re-read by WithWatch get their own value.
+// A parse error is returned wrapped in a ChallengeError.
+//
+// Example:
+//
+//	err := RunParsed(inputData, parseNumbers, func(numbers []int) int { return numbers[0] }, func(numbers []int) int { return len(numbers) })
+func RunParsed[I any, T comparable](input string, parse func(string) (I, error), partOne, partTwo func(I) T, options ...RunOption) error {
+	cache := parseCache[I]{parse: parse}
+
+	withParsed := func(solve func(I) T) ChallengeE[T] {
+		if solve == nil {
+			return nil
+		}
+
+		return func(input string) (answer T, err error) {
+			value, err := cache.get(input)
+			if err != nil {
+				return answer, err
+			}
+
+			return solve(value), nil
+		}
+	}
+
+	_, err := run(context.Background(), []ChallengeE[T]{withParsed(partOne), withParsed(partTwo)}, withInput(input, options)...)
+
+	return err
+}
+
+// maxParsedInputs is the number of inputs whose parsed value a parseCache keeps, so watching an input does not
+// keep the parsed value of every version of it.
+const maxParsedInputs = 16
+
+// parseCache parses each input once, for the parts executed against it.
+type parseCache[I any] struct {
+	parse func(string) (I, error)
+
+	mu     sync.Mutex
+	parsed map[string]func() (I, error)
+}
+
+// get returns the parsed value of input, parsing it on the first call for input. Concurrent calls for the same
+// input wait for the same parse.
+func (c *parseCache[I]) get(input string) (I, error) {
+	c.mu.Lock()
+
+	parsed, ok := c.parsed[input]
+	if !ok {
+		if c.parsed == nil || len(c.parsed) >= maxParsedInputs {
+			c.parsed = make(map[string]func() (I, error))
+		}
+
+		parsed = sync.OnceValues(func() (I, error) { return c.parse(input) })
+		c.parsed[input] = parsed
+	}
+
+	c.mu.Unlock()
+
+	return parsed()
+}
+
+// RunLines works like Run, but hands the challenges the lines of the input, split by Lines, instead of
+// the raw string. The input is split only once for both parts.
+//
+// Example:
+//
+//	err := RunLines(inputData, func(lines []string) int { return len(lines) }, part2Func)
+func RunLines[T comparable](input string, partOne, partTwo func(lines []string) T, options ...RunOption) error {
+	return RunParsed(input, func(input string) ([]string, error) { return Lines(input), nil }, partOne, partTwo, options...)
+}
+
+// RunBlocks works like Run, but hands the challenges the blocks of lines of the input, separated by blank
+// lines and split by Blocks, instead of the raw string. The input is split only once for both parts.
+//
+// Example:
+//
+//	err := RunBlocks(inputData, func(blocks [][]string) int { return len(blocks) }, part2Func)
+func RunBlocks[T comparable](input string, partOne, partTwo func(blocks [][]string) T, options ...RunOption) error {
+	return RunParsed(input, func(input string) ([][]string, error) { return Blocks(input), nil }, partOne, partTwo, options...)
+}
+
+// Lines splits the input into lines, accepting "\n" and "\r\n" line breaks. Trailing line breaks are
+// ignored, so an input ending with a line break has no empty last line, and an empty input has no lines.
+// It is parse.Lines, along with the other helpers of the parse package.
+func Lines(input string) []string {
+	return parse.Lines(input)
+}
+
+// Blocks splits the input into blocks of lines separated by one or more blank lines, such as the
+// sections of a puzzle input. The blank lines are not part of any block. It is parse.Blocks.
+func Blocks(input string) [][]string {
+	return parse.Blocks(input)
+}
+
+// Solve works like Run, but without an input parameter: the input is taken from an input option,
+// such as WithInputString, WithInputFile or WithInputFunc. The input is only loaded after the part
+// has been resolved, so expensive sources are not touched when, for instance, flag parsing fails.
+// Without an input option, a piped stdin is read as the input, see WithInputStdin, and otherwise
+// Solve returns ErrMissingInput.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputFile("input.txt"))
+func Solve[T comparable](partOne, partTwo Challenge[T], options ...RunOption) error {
+	_, err := run(context.Background(), withErrors(partOne, partTwo), options...)
+
+	return err
+}
+
+// RunParts works like Run, but for challenges with any number of parts, such as practice sets or
+// private events with more than two parts. challenges[0] is part 1, challenges[1] is part 2, and so on.
+// The selected part is validated against the number of challenges provided.
+//
+// Example:
+//
+//	err := RunParts(inputData, []Challenge[int]{part1Func, part2Func, part3Func}, WithPart(3))
+func RunParts[T comparable](input string, challenges []Challenge[T], options ...RunOption) error {
+	_, err := run(context.Background(), withErrors(challenges...), withInput(input, options)...)
+
+	return err
+}
+
+// withErrors adapts the given challenges into ChallengeE functions that never fail.
+func withErrors[T comparable](challenges ...Challenge[T]) []ChallengeE[T] {
+	adapted := make([]ChallengeE[T], len(challenges))
+	for i, challenge := range challenges {
+		adapted[i] = challenge.withError()
+	}
+
+	return adapted
+}
+
+// run is the common implementation behind all Run variants.
+func run[T comparable](ctx context.Context, challenges []ChallengeE[T], options ...RunOption) ([]Result[T], error) {
 	var opts runOptions
-	if err := injectOptions(&opts, options...); err != nil {
-		return err
+
+	results, err := runWith(ctx, &opts, challenges, options...)
+	if err != nil {
+		for _, handle := range opts.onError {
+			handle(err)
+		}
+	}
+
+	return results, err
+}
+
+// runWith configures opts from options and runs the challenges with them.
+func runWith[T comparable](ctx context.Context, opts *runOptions, challenges []ChallengeE[T], options ...RunOption) ([]Result[T], error) {
+	if err := injectOptions(opts, len(challenges), options...); err != nil {
+		return nil, err
+	}
+
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+
+	if opts.waitUnlock {
+		if err := opts.waitForUnlock(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.describe {
+		return nil, opts.writeDescription(ctx)
+	}
+
+	opts.writeBanner(ctx)
+
+	if opts.watch {
+		return watch(ctx, opts, challenges)
+	}
+
+	return execute(ctx, opts, challenges)
+}
+
+// execute loads the input and runs the challenges with the configured opts, writing their answers.
+func execute[T comparable](ctx context.Context, opts *runOptions, challenges []ChallengeE[T]) ([]Result[T], error) {
+	if len(opts.namedInputs) > 0 {
+		return runNamedInputs(ctx, opts, challenges)
+	}
+
+	input, err := opts.loadInput(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := newMetadata(input, time.Now())
+	metadata.Seed = opts.seed
+
+	results, err := executeParts(ctx, input, challenges, *opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		results[i].Metadata = metadata
+	}
+
+	for _, result := range results {
+		if err := acceptResult(*opts, result); err != nil {
+			return results, err
+		}
+
+		answer, err := renderAnswer(*opts, result.Answer)
+		if err != nil {
+			return results, err
+		}
+
+		if err := opts.writer.Write(answer); err != nil {
+			return results, err
+		}
+
+		if opts.metadata != nil {
+			if err := writeMetadata(opts.metadata, result); err != nil {
+				return results, err
+			}
+		}
+
+		opts.notifyLongRun(ctx, result.Part, formatAnswer(result.Answer), result.Duration)
+
+		if opts.verify && !opts.sample {
+			if err := opts.verifyAnswer(result.Part, formatAnswer(result.Answer)); err != nil {
+				return results, err
+			}
+		}
+
+		if opts.submitMode != 0 && !opts.sample {
+			if err := opts.submitAnswer(ctx, result.Part, formatAnswer(result.Answer)); err != nil {
+				return results, err
+			}
+		}
 	}
 
-	result := executeChallenge(input, partOne, partTwo, opts.part)
+	return results, nil
+}
 
-	if err := opts.manager.Write(strconv.Itoa(result)); err != nil {
+// acceptResult validates the result with the validators of opts, then calls the after-run hooks with it.
+func acceptResult[T comparable](opts runOptions, result Result[T]) error {
+	if err := opts.validate(result.Part, result.Answer); err != nil {
 		return err
 	}
 
+	for _, hook := range opts.afterRun {
+		if err := hook(result); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// WithManager creates a RunOption to set the custom IOManager.
+// WithManager creates a RunOption to set the custom IOManager, used both to read the part and to write answers.
 // Use this to override the default console-based manager.
 //
 // Example:
@@ -104,7 +521,37 @@ func Run(input string, partOne, partTwo Challenge, options ...RunOption) error {
 //	err := Run(inputData, part1Func, part2Func, WithManager(manager))
 func WithManager(manager IOManager) RunOption {
 	return func(options *runOptions) error {
-		options.manager = manager
+		options.reader = manager
+		options.writer = manager
+
+		return nil
+	}
+}
+
+// WithReader creates a RunOption to set the custom InputReader used to read the part,
+// keeping the configured OutputWriter.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithReader(NewCustomReader()))
+func WithReader(reader InputReader) RunOption {
+	return func(options *runOptions) error {
+		options.reader = reader
+
+		return nil
+	}
+}
+
+// WithWriter creates a RunOption to set the custom OutputWriter used to write answers,
+// keeping the configured InputReader, e.g. to write answers to a file while the part is still read
+// from the console.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithWriter(NewJSONWriter("answers.json")))
+func WithWriter(writer OutputWriter) RunOption {
+	return func(options *runOptions) error {
+		options.writer = writer
 
 		return nil
 	}
@@ -112,57 +559,573 @@ func WithManager(manager IOManager) RunOption {
 
 // WithPart creates a RunOption to specify which part of the challenge to run (part 1 or 2).
 // This is particularly useful when you want to determine the part dynamically.
+// A part lower than 1 fails at option time with an InvalidPartError; the upper bound is checked
+// against the number of challenges when the run starts.
 //
 // Example:
 //
 //	err := Run(inputData, part1Func, part2Func, WithPart(2))
 func WithPart(part int) RunOption {
 	return func(options *runOptions) error {
+		if part < 1 {
+			return InvalidPartError{Part: part}
+		}
+
 		options.part = Part(part)
 
 		return nil
 	}
 }
 
-// executeChallenge applies the appropriate Challenge function based on the selected part.
-// It returns the result of the challenge execution.
-func executeChallenge(input string, partOne, partTwo Challenge, part Part) (result int) {
-	switch part {
-	case 1:
-		result = partOne(input)
-	case 2:
-		result = partTwo(input)
-	default:
+// WithBothParts creates a RunOption to execute part 1 and part 2 in a single run, or every part
+// for challenges run with RunParts. The parts are executed concurrently, unless WithSequential is also
+// given, and their answers are written in part order. The same mode can be selected at runtime with
+// the part value "both" (or "all").
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithBothParts())
+func WithBothParts() RunOption {
+	return func(options *runOptions) error {
+		options.allParts = true
+
+		return nil
+	}
+}
+
+// WithSequential creates a RunOption that forces the parts to be executed one after the other when
+// both parts are run. Use it for solutions whose parts share mutable state.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithBothParts(), WithSequential())
+func WithSequential() RunOption {
+	return func(options *runOptions) error {
+		options.sequential = true
+
+		return nil
+	}
+}
+
+// WithTimeout creates a RunOption that bounds the execution time of the challenge.
+// When a part takes longer than d, Run returns a TimeoutError, which also matches context.DeadlineExceeded.
+// As with RunContext, the abandoned challenge keeps running in its goroutine until it returns.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithPart(2), WithTimeout(30*time.Second))
+func WithTimeout(d time.Duration) RunOption {
+	return func(options *runOptions) error {
+		options.timeout = d
+
+		return nil
+	}
+}
+
+// WithRetries creates a RunOption for nondeterministic solutions, such as randomized searches.
+// The selected part is re-executed up to n more times until accept returns true for its answer.
+// When every attempt is rejected, Run returns a RetryError with the last answer. Errors and panics
+// raised by the challenge are returned right away, without retrying. The timeout set by WithTimeout
+// and the reported duration cover all attempts of a part.
+// The predicate must accept the answer type of the challenges, otherwise Run fails with an AnswerTypeError.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, annealPart2, WithRetries(10, func(answer int) bool { return answer < 1000 }))
+func WithRetries[T comparable](n int, accept func(answer T) bool) RunOption {
+	return func(options *runOptions) error {
+		if n < 0 {
+			return ErrNegativeRetries
+		}
+
+		options.retries = n
+		options.accept = func(answer any) (bool, error) {
+			typed, ok := answer.(T)
+			if !ok {
+				return false, newAnswerTypeError[T]("WithRetries", answer)
+			}
+
+			return accept(typed), nil
+		}
+
+		return nil
+	}
+}
+
+// WithValidator creates a RunOption that checks each answer before it is written, e.g. to assert that it
+// is positive or below a previous "too high" guess. When validate returns an error, nothing is written
+// and Run returns a ValidationError wrapping it. Validators run in registration order.
+// The validator must accept the answer type of the challenges, otherwise Run fails with an AnswerTypeError.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithValidator(func(answer int) error {
+//	    if answer >= 1234 {
+//	        return errors.New("already known to be too high")
+//	    }
+//
+//	    return nil
+//	}))
+func WithValidator[T comparable](validate func(answer T) error) RunOption {
+	return func(options *runOptions) error {
+		options.validators = append(options.validators, func(answer any) error {
+			typed, ok := answer.(T)
+			if !ok {
+				return newAnswerTypeError[T]("WithValidator", answer)
+			}
+
+			return validate(typed)
+		})
+
+		return nil
+	}
+}
+
+// validate runs every validator registered with WithValidator against the answer of part.
+func (o runOptions) validate(part Part, answer any) error {
+	for _, validator := range o.validators {
+		if err := validator(answer); err != nil {
+			var typeErr AnswerTypeError
+			if errors.As(err, &typeErr) {
+				return err
+			}
+
+			return ValidationError{Part: part, Answer: fmt.Sprint(answer), Err: err}
+		}
+	}
+
+	return nil
+}
+
+// WithFormatter creates a RunOption that sets the function rendering answers of type T before the OutputWriter
+// writes them, and the console manager copies them to the clipboard, e.g. as hex, comma-grouped or padded numbers.
+// It replaces the default rendering, which writes numbers in base 10 and strings as they are.
+// If the answers are not of type T, the run fails with an AnswerTypeError.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithFormatter(func(answer int) string {
+//	    return fmt.Sprintf("%x", answer)
+//	}))
+func WithFormatter[T comparable](format func(answer T) string) RunOption {
+	return func(options *runOptions) error {
+		options.formatter = func(answer any) (string, error) {
+			typed, ok := answer.(T)
+			if !ok {
+				return "", newAnswerTypeError[T]("WithFormatter", answer)
+			}
+
+			return format(typed), nil
+		}
+
+		return nil
+	}
+}
+
+// renderAnswer renders the answer with the formatter set by WithFormatter, or with formatAnswer when there is none.
+func renderAnswer[T comparable](opts runOptions, answer T) (string, error) {
+	if opts.formatter == nil {
+		return formatAnswer(answer), nil
+	}
+
+	return opts.formatter(answer)
+}
+
+// WithRecover creates a RunOption that enables or disables the recovery of panics raised by challenges.
+// Recovery is enabled by default, turning panics into a ChallengePanicError. Disable it while debugging,
+// so the original panic and its full stack trace reach the debugger unmodified.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithRecover(false))
+func WithRecover(enabled bool) RunOption {
+	return func(options *runOptions) error {
+		options.noRecover = !enabled
+
+		return nil
+	}
+}
+
+// WithBeforeRun creates a RunOption that registers a hook called right before a part is executed,
+// with the part and the input it receives. Hooks are called in registration order and never concurrently,
+// even when both parts run in parallel.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithBeforeRun(func(part Part, input string) {
+//	    log.Printf("running part %d over %d bytes", part, len(input))
+//	}))
+func WithBeforeRun(hook func(part Part, input string)) RunOption {
+	return func(options *runOptions) error {
+		options.beforeRun = append(options.beforeRun, hook)
+
+		return nil
+	}
+}
+
+// WithAfterRun creates a RunOption that registers a hook called with the Result of each executed part,
+// before the answer is written by the OutputWriter. Hooks are not called for parts that failed.
+// The hook must accept a Result of the same answer type as the challenges, otherwise Run fails
+// with an AnswerTypeError.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithAfterRun(func(result Result[int]) {
+//	    log.Printf("part %d took %s", result.Part, result.Duration)
+//	}))
+func WithAfterRun[T comparable](hook func(result Result[T])) RunOption {
+	return func(options *runOptions) error {
+		options.afterRun = append(options.afterRun, func(result any) error {
+			typed, ok := result.(Result[T])
+			if !ok {
+				return newAnswerTypeError[Result[T]]("WithAfterRun", result)
+			}
+
+			hook(typed)
+
+			return nil
+		})
+
+		return nil
+	}
+}
+
+// WithOnError creates a RunOption that registers a handler called with any error the run returns,
+// whether it comes from the options, the input, the execution or the output, right before it is returned.
+// It centralizes failure handling, e.g. telemetry or desktop notifications, in wrappers around Run.
+// An error from an option given before WithOnError is not reported to the handler.
+//
+// Example:
+//
+//	err := Run(inputData, part1Func, part2Func, WithOnError(func(err error) {
+//	    log.Printf("run failed: %v", err)
+//	}))
+func WithOnError(handle func(err error)) RunOption {
+	return func(options *runOptions) error {
+		options.onError = append(options.onError, handle)
+
+		return nil
+	}
+}
+
+// notifyBeforeRun calls every hook registered with WithBeforeRun.
+func (o runOptions) notifyBeforeRun(part Part, input string) {
+	for _, hook := range o.beforeRun {
+		hook(part, input)
+	}
+}
+
+// executeParts executes every selected part and returns their results in part order.
+// Parts run concurrently unless sequential execution was requested. If any part fails,
+// the error of the first failing part is returned.
+func executeParts[T comparable](ctx context.Context, input string, challenges []ChallengeE[T], opts runOptions) ([]Result[T], error) {
+	parts := opts.selectedParts(len(challenges))
+	results := make([]Result[T], len(parts))
+	errs := make([]error, len(parts))
+
+	if opts.sequential || len(parts) == 1 {
+		for i, part := range parts {
+			opts.notifyBeforeRun(part, input)
+
+			if results[i], errs[i] = executePart(ctx, input, challenges, part, opts); errs[i] != nil {
+				return nil, errs[i]
+			}
+		}
+
+		return results, nil
+	}
+
+	var wg sync.WaitGroup
+
+	for i, part := range parts {
+		opts.notifyBeforeRun(part, input)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i], errs[i] = executePart(ctx, input, challenges, part, opts)
+		}()
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// executePart executes a single part, bounded by the configured timeout and memory limit when they are
+// positive, and measures its duration. When retries are configured, the part is re-executed until its answer is accepted.
+func executePart[T comparable](ctx context.Context, input string, challenges []ChallengeE[T], part Part, opts runOptions) (Result[T], error) {
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeoutCause(ctx, opts.timeout, TimeoutError{Part: part, Timeout: opts.timeout})
+		defer cancel()
+	}
+
+	if opts.memoryLimit > 0 {
+		var stop func()
+
+		ctx, stop = watchMemory(ctx, part, opts.memoryLimit)
+		defer stop()
+	}
+
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		answer, err := executeChallenge(ctx, input, challenges, part, !opts.noRecover)
+		if err != nil {
+			return Result[T]{}, err
+		}
+
+		if opts.accept == nil {
+			return Result[T]{Answer: answer, Part: part, Duration: time.Since(start)}, nil
+		}
+
+		accepted, err := opts.accept(answer)
+		if err != nil {
+			return Result[T]{}, err
+		}
+
+		if accepted {
+			return Result[T]{Answer: answer, Part: part, Duration: time.Since(start)}, nil
+		}
+
+		if attempt > opts.retries {
+			return Result[T]{}, RetryError{Part: part, Attempts: attempt, Answer: formatAnswer(answer)}
+		}
+	}
+}
+
+// executeChallenge runs solvePart, returning the cause of ctx being done as soon as it happens.
+// Panics are recovered into a ChallengePanicError when recoverPanics is true.
+// The challenge is only moved to a separate goroutine when ctx can actually be cancelled.
+func executeChallenge[T comparable](ctx context.Context, input string, challenges []ChallengeE[T], part Part, recoverPanics bool) (result T, err error) {
+	solve := recoverPart[T]
+	if !recoverPanics {
+		solve = solvePart[T]
+	}
+
+	if ctx.Err() != nil {
+		return result, context.Cause(ctx)
+	}
+
+	if ctx.Done() == nil {
+		return solve(input, challenges, part)
+	}
+
+	type outcome struct {
+		result T
+		err    error
+	}
+
+	done := make(chan outcome, 1)
+
+	go func() {
+		result, err := solve(input, challenges, part)
+		done <- outcome{result: result, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return result, context.Cause(ctx)
+	case out := <-done:
+		return out.result, out.err
+	}
+}
+
+// recoverPart runs solvePart, recovering a panic raised by the challenge into a ChallengePanicError.
+func recoverPart[T comparable](input string, challenges []ChallengeE[T], part Part) (result T, err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			err = ChallengePanicError{Part: part, Value: value, Stack: trimStack(debug.Stack())}
+		}
+	}()
+
+	return solvePart(input, challenges, part)
+}
+
+// solvePart applies the appropriate Challenge function based on the selected part.
+// It returns the result of the challenge execution, or a ChallengeError if the challenge failed.
+// A part without a matching challenge is reported as ErrUnexpectedPart, wrapping an InvalidPartError,
+// and a nil challenge is reported as ErrPartNotImplemented.
+func solvePart[T comparable](input string, challenges []ChallengeE[T], part Part) (result T, err error) {
+	if part < 1 || int(part) > len(challenges) {
 		// Though should never reach, it is good for future-proofing
-		panic(ErrMissingPart)
+		return result, fmt.Errorf("%w: %w", ErrUnexpectedPart, InvalidPartError{Part: int(part), Parts: len(challenges)})
 	}
 
-	return result
+	challenge := challenges[part-1]
+	if challenge == nil {
+		return result, fmt.Errorf("%w: %d", ErrPartNotImplemented, part)
+	}
+
+	result, err = challenge(input)
+
+	if err != nil {
+		return result, ChallengeError{Part: part, Err: err}
+	}
+
+	return result, nil
 }
 
-// injectOptions applies the functional options to configure runOptions.
-// It defaults the IOManager to a console manager and resolves the challenge part from input if not set.
-func injectOptions(opts *runOptions, options ...RunOption) error {
+// trimStack reduces a stack trace captured while recovering a challenge panic to the frames between
+// the panic call and the goaoc frame that invoked the challenge, i.e. the frames of the solution itself.
+// The full stack is returned if its layout is not recognized.
+func trimStack(stack []byte) string {
+	lines := strings.Split(strings.TrimRight(string(stack), "\n"), "\n")
+
+	start := -1
+
+	for i := 1; i+1 < len(lines); i += 2 {
+		if start == -1 {
+			if strings.HasPrefix(lines[i], "panic(") {
+				start = i + 2
+			}
+
+			continue
+		}
+
+		if strings.HasPrefix(lines[i], "runtime.") && i == start {
+			start = i + 2
+
+			continue
+		}
+
+		if strings.HasPrefix(lines[i], "github.com/hvpaiva/goaoc.") {
+			return strings.Join(lines[start:i], "\n")
+		}
+	}
+
+	if start == -1 || start >= len(lines) {
+		return string(stack)
+	}
+
+	return strings.Join(lines[start:], "\n")
+}
+
+// formatAnswer converts the answer returned by a Challenge into the string handed to the OutputWriter.
+// String answers are passed through untouched, except for trailing newlines, so multi-line answers
+// (e.g. letters drawn with '#') keep their layout. Integers, including uint64 and *big.Int answers
+// that do not fit in an int, are written in base 10.
+func formatAnswer[T comparable](answer T) string {
+	switch value := any(answer).(type) {
+	case string:
+		return strings.TrimRight(value, "\r\n")
+	case int:
+		return strconv.Itoa(value)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case uint64:
+		return strconv.FormatUint(value, 10)
+	case *big.Int:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// applyOptions applies the functional options to opts, without the defaults of a run, e.g. for the requests
+// to adventofcode.com made outside of one. The first option returning an error is wrapped in an OptionError.
+func applyOptions(opts *runOptions, options ...RunOption) error {
 	for _, option := range options {
-		_ = option(opts)
+		if err := option(opts); err != nil {
+			return OptionError{Err: err}
+		}
+	}
+
+	return nil
+}
+
+// injectOptions applies the functional options to configure runOptions.
+// It defaults the InputReader and OutputWriter to console managers and resolves the challenge part from input if not set.
+// The first option returning an error aborts the run, with the error wrapped in an OptionError.
+func injectOptions(opts *runOptions, count int, options ...RunOption) error {
+	if err := applyOptions(opts, options...); err != nil {
+		return err
+	}
+
+	if opts.reader == nil {
+		opts.reader = NewConsoleManager()
+	}
+
+	if opts.writer == nil {
+		opts.writer = NewConsoleManager()
+	}
+
+	if console, ok := configureConsole(opts.reader, opts.console); ok {
+		opts.reader = console
+	}
+
+	if console, ok := configureConsole(opts.writer, opts.console); ok {
+		opts.writer = console
 	}
 
-	if opts.manager == nil {
-		opts.manager = NewConsoleManager()
+	if err := opts.resolveProfile(); err != nil {
+		return err
+	}
+
+	opts.inferPuzzle()
+
+	if !opts.sample && !opts.stream {
+		sample, err := sampleInConsole(opts.reader)
+		if err != nil {
+			return err
+		}
+
+		opts.sample = sample
 	}
 
-	if opts.part == 0 {
-		partStr, err := opts.manager.Read("part")
+	if !opts.verify {
+		verify, err := verifyInConsole(opts.reader)
 		if err != nil {
 			return err
 		}
 
+		opts.verify = verify
+	}
+
+	describe, err := describeInConsole(opts.reader)
+	if err != nil {
+		return err
+	}
+
+	if opts.describe = describe; describe {
+		// The puzzle is described instead of run, so the part is not needed.
+		return nil
+	}
+
+	if opts.part != 0 {
+		if _, err := NewPartOf(int(opts.part), count); err != nil {
+			return err
+		}
+	}
+
+	if opts.part == 0 && !opts.allParts {
+		partStr, err := opts.reader.Read("part")
+		if err != nil {
+			return err
+		}
+
+		if partStr == "both" || partStr == "all" {
+			opts.allParts = true
+
+			return nil
+		}
+
 		part, err := strconv.Atoi(partStr)
 		if err != nil {
 			return ErrInvalidPartType
 		}
 
-		opts.part, err = NewPart(part)
+		opts.part, err = NewPartOf(part, count)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSolvePartWithUnexpectedPart(t *testing.T) {
+	challenges := []ChallengeE[int]{
+		func(_ string) (int, error) { return 1, nil },
+		func(_ string) (int, error) { return 2, nil },
+	}
+
+	for _, part := range []Part{0, 3, -1} {
+		_, err := solvePart("input", challenges, part)
+
+		if !errors.Is(err, ErrUnexpectedPart) {
+			t.Fatalf("Expected ErrUnexpectedPart for part %d, but got: %v", part, err)
+		}
+
+		var partErr InvalidPartError
+		if !errors.As(err, &partErr) || partErr.Part != int(part) || partErr.Parts != 2 {
+			t.Errorf("Expected InvalidPartError with the offending part %d, but got: %v", part, err)
+		}
+	}
+}
+
+func TestInjectOptionsPropagatesOptionErrors(t *testing.T) {
+	errOption := errors.New("option failed")
+	applied := false
+
+	var opts runOptions
+
+	err := injectOptions(&opts, 2,
+		func(_ *runOptions) error { return errOption },
+		func(_ *runOptions) error { applied = true; return nil })
+
+	if !errors.Is(err, errOption) || err.Error() != "invalid run option: option failed" {
+		t.Fatalf("Expected wrapped option error, but got: %v", err)
+	}
+
+	if applied {
+		t.Error("Expected options after the failing one not to be applied")
+	}
+}
+
+func TestExecuteChallengeWithoutRecover(t *testing.T) {
+	challenges := []ChallengeE[int]{
+		func(_ string) (int, error) { panic("boom") },
+	}
+
+	defer func() {
+		if value := recover(); value != "boom" {
+			t.Errorf("Expected the original panic value, but got: %v", value)
+		}
+	}()
+
+	_, _ = executeChallenge(context.Background(), "input", challenges, 1, false)
+
+	t.Fatal("Expected the challenge panic to propagate")
+}
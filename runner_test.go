@@ -5,8 +5,19 @@
 package goaoc_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hvpaiva/goaoc"
 	"github.com/hvpaiva/goaoc/mock"
@@ -90,6 +101,207 @@ func TestRunWithValidPart(t *testing.T) {
 	}
 }
 
+func TestRunWithFailingOptions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		option    goaoc.RunOption
+		expectErr string
+	}{
+		{"PartZero", goaoc.WithPart(0), "invalid run option: invalid part: 0. The valid parts are (1/2)"},
+		{"NegativePart", goaoc.WithPart(-2), "invalid run option: invalid part: -2. The valid parts are (1/2)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			called := false
+			mok := mock.NewManager("1", nil, nil)
+			err := goaoc.Run("input", func(_ string) int { called = true; return 1 }, mockPartTwo, goaoc.WithManager(&mok), tc.option)
+
+			var optionErr goaoc.OptionError
+			if err == nil || err.Error() != tc.expectErr || !errors.As(err, &optionErr) {
+				t.Fatalf("Expected OptionError '%s', but got: %v", tc.expectErr, err)
+			}
+
+			if called {
+				t.Error("Expected the challenge not to be executed")
+			}
+		})
+	}
+
+	t.Run("InvalidPartErrorIsWrapped", func(t *testing.T) {
+		err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithPart(0))
+
+		var partErr goaoc.InvalidPartError
+		if !errors.As(err, &partErr) || partErr.Part != 0 {
+			t.Fatalf("Expected InvalidPartError, but got: %v", err)
+		}
+	})
+}
+
+func TestRunWithStreams(t *testing.T) {
+	var stdout bytes.Buffer
+
+	err := goaoc.Run("input", mockPartOne, mockPartTwo,
+		goaoc.WithArgs([]string{}),
+		goaoc.WithEnvLookup(func(key string) string {
+			if key == "GOAOC_DISABLE_COPY_CLIPBOARD" {
+				return "true"
+			}
+
+			return ""
+		}),
+		goaoc.WithStdin(strings.NewReader("2\n")),
+		goaoc.WithStdout(&stdout))
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expectedOutput := "Which part do you want to run? (1/2/both)\nThe challenge result is 24\n"
+	if stdout.String() != expectedOutput {
+		t.Errorf("Expected output '%s', but got '%s'", expectedOutput, stdout.String())
+	}
+}
+
+type writerStub struct {
+	results []string
+}
+
+func (w *writerStub) Write(result string) error {
+	w.results = append(w.results, result)
+
+	return nil
+}
+
+func TestRunWithReaderAndWriter(t *testing.T) {
+	t.Run("CustomWriter", func(t *testing.T) {
+		var (
+			stdout bytes.Buffer
+			writer writerStub
+		)
+
+		err := goaoc.Run("input", mockPartOne, mockPartTwo,
+			goaoc.WithArgs([]string{}),
+			goaoc.WithStdin(strings.NewReader("2\n")),
+			goaoc.WithStdout(&stdout),
+			goaoc.WithWriter(&writer))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(writer.results, []string{"24"}) {
+			t.Errorf("Expected the custom writer to receive [24], but got %v", writer.results)
+		}
+
+		expectedOutput := "Which part do you want to run? (1/2/both)\n"
+		if stdout.String() != expectedOutput {
+			t.Errorf("Expected output '%s', but got '%s'", expectedOutput, stdout.String())
+		}
+	})
+
+	t.Run("CustomReader", func(t *testing.T) {
+		var writer writerStub
+
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", mockPartOne, mockPartTwo,
+			goaoc.WithWriter(&writer),
+			goaoc.WithReader(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(writer.results, []string{"42"}) || mok.GetStdout() != "" {
+			t.Errorf("Expected only the custom writer to receive [42], but got %v", writer.results)
+		}
+	})
+}
+
+func TestRunWithNilPartTwo(t *testing.T) {
+	testCases := []struct {
+		name           string
+		part           string
+		expectedOutput string
+		expectErr      string
+	}{
+		{"PartOne", "1", "The challenge result is 42\n", ""},
+		{"PartTwo", "2", "", "challenge part not implemented yet: 2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager(tc.part, nil, nil)
+			err := goaoc.Run("input", mockPartOne, nil, goaoc.WithManager(&mok))
+
+			if tc.expectErr != "" {
+				if !errors.Is(err, goaoc.ErrPartNotImplemented) || err.Error() != tc.expectErr {
+					t.Fatalf("Expected error '%s', but got: %v", tc.expectErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if output := mok.GetStdout(); output != tc.expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", tc.expectedOutput, output)
+			}
+		})
+	}
+
+	t.Run("RunParsed", func(t *testing.T) {
+		mok := mock.NewManager("2", nil, nil)
+		err := goaoc.RunParsed("input", func(input string) (string, error) { return input, nil }, echoPart, nil, goaoc.WithManager(&mok))
+
+		if !errors.Is(err, goaoc.ErrPartNotImplemented) {
+			t.Fatalf("Expected ErrPartNotImplemented, but got: %v", err)
+		}
+	})
+}
+
+func TestRunConcurrently(t *testing.T) {
+	var (
+		stdout bytes.Buffer
+		wg     sync.WaitGroup
+	)
+
+	noClipboard := func(key string) string {
+		if key == "GOAOC_DISABLE_COPY_CLIPBOARD" {
+			return "true"
+		}
+
+		return ""
+	}
+
+	errs := make([]error, 20)
+
+	for i := range errs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			part := i%2 + 1
+			errs[i] = goaoc.Run("input", mockPartOne, mockPartTwo,
+				goaoc.WithArgs([]string{fmt.Sprintf("-part=%d", part)}),
+				goaoc.WithEnvLookup(noClipboard),
+				goaoc.WithStdout(&stdout))
+		}()
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("Unexpected error in run %d: %v", i, err)
+		}
+	}
+
+	output := stdout.String()
+	if strings.Count(output, "The challenge result is 42\n") != 10 || strings.Count(output, "The challenge result is 24\n") != 10 {
+		t.Errorf("Expected 10 answers of each part, but got:\n%s", output)
+	}
+}
+
 func TestRunWithDefaultManager(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -110,6 +322,826 @@ func TestRunWithDefaultManager(t *testing.T) {
 	}
 }
 
+func TestRunWithGenericAnswers(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", func(_ string) string { return "CMZ" }, func(_ string) string { return "MCD" }, goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is CMZ\n" {
+			t.Errorf("Expected output 'The challenge result is CMZ\n', but got '%s'", output)
+		}
+	})
+
+	t.Run("StringTrailingNewline", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", func(_ string) string { return "#..#\n####\n" }, func(_ string) string { return "" }, goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is #..#\n####\n" {
+			t.Errorf("Expected trailing newline to be trimmed, but got '%s'", output)
+		}
+	})
+
+	t.Run("BigInt", func(t *testing.T) {
+		answer, _ := new(big.Int).SetString("340282366920938463463374607431768211456", 10)
+
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", func(_ string) *big.Int { return answer }, func(_ string) *big.Int { return big.NewInt(0) }, goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is 340282366920938463463374607431768211456\n" {
+			t.Errorf("Expected output 'The challenge result is 340282366920938463463374607431768211456\n', but got '%s'", output)
+		}
+	})
+
+	t.Run("Int64", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", func(_ string) int64 { return -9223372036854775808 }, func(_ string) int64 { return 0 }, goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is -9223372036854775808\n" {
+			t.Errorf("Expected output 'The challenge result is -9223372036854775808\n', but got '%s'", output)
+		}
+	})
+
+	t.Run("Uint64", func(t *testing.T) {
+		mok := mock.NewManager("2", nil, nil)
+		err := goaoc.Run("input", func(_ string) uint64 { return 1 }, func(_ string) uint64 { return 18446744073709551615 }, goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is 18446744073709551615\n" {
+			t.Errorf("Expected output 'The challenge result is 18446744073709551615\n', but got '%s'", output)
+		}
+	})
+}
+
+func TestRunE(t *testing.T) {
+	errParse := errors.New("parse failed")
+
+	testCases := []struct {
+		name           string
+		part           string
+		expectedOutput string
+		expectErr      string
+	}{
+		{"PartOne", "1", "The challenge result is 42\n", ""},
+		{"PartTwoFails", "2", "", "challenge part 2 failed: parse failed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager(tc.part, nil, nil)
+			err := goaoc.RunE("input",
+				func(_ string) (int, error) { return 42, nil },
+				func(_ string) (int, error) { return 0, errParse },
+				goaoc.WithManager(&mok))
+
+			if tc.expectErr != "" {
+				if err == nil || err.Error() != tc.expectErr {
+					t.Fatalf("Expected error '%s', but got: %v", tc.expectErr, err)
+				}
+
+				var challengeErr goaoc.ChallengeError
+				if !errors.As(err, &challengeErr) || challengeErr.Part != 2 || !errors.Is(err, errParse) {
+					t.Errorf("Expected ChallengeError wrapping the parse error for part 2, but got: %#v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if output := mok.GetStdout(); output != tc.expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", tc.expectedOutput, output)
+			}
+		})
+	}
+}
+
+func TestRunContext(t *testing.T) {
+	t.Run("Completes", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.RunContext(context.Background(), "input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is 42\n" {
+			t.Errorf("Expected output 'The challenge result is 42\n', but got '%s'", output)
+		}
+	})
+
+	t.Run("AlreadyCancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		called := false
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.RunContext(ctx, "input", func(_ string) int { called = true; return 1 }, mockPartTwo, goaoc.WithManager(&mok))
+
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected context.Canceled, but got: %v", err)
+		}
+
+		if called {
+			t.Error("Expected the challenge not to be executed")
+		}
+	})
+
+	t.Run("CancelledWhileRunning", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		release := make(chan struct{})
+		defer close(release)
+
+		mok := mock.NewManager("2", nil, nil)
+		err := goaoc.RunContext(ctx, "input", mockPartOne, func(_ string) int { <-release; return 1 }, goaoc.WithManager(&mok))
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected context.DeadlineExceeded, but got: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "" {
+			t.Errorf("Expected no output, but got '%s'", output)
+		}
+	})
+}
+
+func TestRunWithTimeout(t *testing.T) {
+	t.Run("Exceeded", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		mok := mock.NewManager("2", nil, nil)
+		err := goaoc.Run("input", mockPartOne, func(_ string) int { <-release; return 1 },
+			goaoc.WithManager(&mok), goaoc.WithTimeout(10*time.Millisecond))
+
+		var timeoutErr goaoc.TimeoutError
+		if !errors.As(err, &timeoutErr) || timeoutErr.Part != 2 || timeoutErr.Timeout != 10*time.Millisecond {
+			t.Fatalf("Expected TimeoutError for part 2, but got: %v", err)
+		}
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected error to match context.DeadlineExceeded, but got: %v", err)
+		}
+
+		if err.Error() != "challenge part 2 timed out after 10ms" {
+			t.Errorf("Unexpected error message: %s", err.Error())
+		}
+	})
+
+	t.Run("WithinLimit", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok), goaoc.WithTimeout(time.Minute))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is 42\n" {
+			t.Errorf("Expected output 'The challenge result is 42\n', but got '%s'", output)
+		}
+	})
+}
+
+func TestRunWithPanickingChallenge(t *testing.T) {
+	testCases := []struct {
+		name    string
+		options []goaoc.RunOption
+	}{
+		{"Synchronous", nil},
+		{"WithTimeout", []goaoc.RunOption{goaoc.WithTimeout(time.Minute)}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager("1", nil, nil)
+			options := append([]goaoc.RunOption{goaoc.WithManager(&mok)}, tc.options...)
+			err := goaoc.Run("input", mockPanickingPart, mockPartTwo, options...)
+
+			var panicErr goaoc.ChallengePanicError
+			if !errors.As(err, &panicErr) || panicErr.Part != 1 {
+				t.Fatalf("Expected ChallengePanicError for part 1, but got: %v", err)
+			}
+
+			var runtimeErr runtime.Error
+			if !errors.As(err, &runtimeErr) {
+				t.Errorf("Expected error to wrap the runtime error, but got: %v", panicErr.Value)
+			}
+
+			if !strings.HasPrefix(panicErr.Stack, "github.com/hvpaiva/goaoc_test.mockPanickingPart") {
+				t.Errorf("Expected stack to start at the panicking function, but got:\n%s", panicErr.Stack)
+			}
+
+			if strings.Contains(panicErr.Stack, "github.com/hvpaiva/goaoc.") {
+				t.Errorf("Expected goaoc frames to be trimmed, but got:\n%s", panicErr.Stack)
+			}
+		})
+	}
+}
+
+func TestRunWithBothParts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		part    string
+		options []goaoc.RunOption
+	}{
+		{"Option", "", []goaoc.RunOption{goaoc.WithBothParts()}},
+		{"ReadBoth", "both", nil},
+		{"Sequential", "", []goaoc.RunOption{goaoc.WithBothParts(), goaoc.WithSequential()}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager(tc.part, nil, nil)
+			options := append([]goaoc.RunOption{goaoc.WithManager(&mok)}, tc.options...)
+			results, err := goaoc.RunResults("input", mockPartOne, mockPartTwo, options...)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(results) != 2 || results[0].Part != 1 || results[0].Answer != 42 || results[1].Part != 2 || results[1].Answer != 24 {
+				t.Errorf("Unexpected results: %+v", results)
+			}
+
+			expectedOutput := "The challenge result is 42\nThe challenge result is 24\n"
+			if output := mok.GetStdout(); output != expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", expectedOutput, output)
+			}
+		})
+	}
+
+	t.Run("Concurrent", func(t *testing.T) {
+		started := make(chan struct{})
+		mok := mock.NewManager("", nil, nil)
+		err := goaoc.Run("input",
+			func(_ string) int { <-started; return 1 },
+			func(_ string) int { close(started); return 2 },
+			goaoc.WithManager(&mok), goaoc.WithBothParts(), goaoc.WithTimeout(time.Second))
+
+		if err != nil {
+			t.Fatalf("Expected parts to run concurrently, but got: %v", err)
+		}
+	})
+
+	t.Run("SequentialOrder", func(t *testing.T) {
+		var order []goaoc.Part
+
+		mok := mock.NewManager("", nil, nil)
+		err := goaoc.Run("input",
+			func(_ string) int { order = append(order, 1); return 1 },
+			func(_ string) int { order = append(order, 2); return 2 },
+			goaoc.WithManager(&mok), goaoc.WithBothParts(), goaoc.WithSequential())
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(order) != 2 || order[0] != 1 || order[1] != 2 {
+			t.Errorf("Expected parts to run in order, but got %v", order)
+		}
+	})
+
+	t.Run("PartFails", func(t *testing.T) {
+		mok := mock.NewManager("", nil, nil)
+		err := goaoc.RunE("input",
+			func(_ string) (int, error) { return 1, nil },
+			func(_ string) (int, error) { return 0, errors.New("boom") },
+			goaoc.WithManager(&mok), goaoc.WithBothParts())
+
+		if err == nil || err.Error() != "challenge part 2 failed: boom" {
+			t.Fatalf("Expected error 'challenge part 2 failed: boom', but got: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "" {
+			t.Errorf("Expected no output, but got '%s'", output)
+		}
+	})
+}
+
+func TestRunWithHooks(t *testing.T) {
+	t.Run("CalledAroundEachPart", func(t *testing.T) {
+		var events []string
+
+		mok := mock.NewManager("", nil, nil)
+		err := goaoc.Run("input", mockPartOne, mockPartTwo,
+			goaoc.WithManager(&mok),
+			goaoc.WithBothParts(),
+			goaoc.WithSequential(),
+			goaoc.WithBeforeRun(func(part goaoc.Part, input string) {
+				events = append(events, fmt.Sprintf("before %d %s", part, input))
+			}),
+			goaoc.WithAfterRun(func(result goaoc.Result[int]) {
+				events = append(events, fmt.Sprintf("after %d %d", result.Part, result.Answer))
+			}))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := []string{"before 1 input", "before 2 input", "after 1 42", "after 2 24"}
+		if !reflect.DeepEqual(events, expected) {
+			t.Errorf("Expected events %v, but got %v", expected, events)
+		}
+	})
+
+	t.Run("AfterRunNotCalledOnFailure", func(t *testing.T) {
+		called := false
+
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", mockPanickingPart, mockPartTwo,
+			goaoc.WithManager(&mok),
+			goaoc.WithAfterRun(func(_ goaoc.Result[int]) { called = true }))
+
+		if err == nil {
+			t.Fatal("Expected an error, but got nil")
+		}
+
+		if called {
+			t.Error("Expected after-run hook not to be called")
+		}
+	})
+
+	t.Run("AnswerTypeMismatch", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", mockPartOne, mockPartTwo,
+			goaoc.WithManager(&mok),
+			goaoc.WithAfterRun(func(_ goaoc.Result[string]) {}))
+
+		expectErr := "option WithAfterRun expects goaoc.Result[string], but got goaoc.Result[int]"
+		if err == nil || err.Error() != expectErr {
+			t.Fatalf("Expected error '%s', but got: %v", expectErr, err)
+		}
+	})
+}
+
+func TestRunWithOnError(t *testing.T) {
+	tests := []struct {
+		name      string
+		part      string
+		partOne   goaoc.Challenge[int]
+		outputErr error
+	}{
+		{"ExecutionError", "1", mockPanickingPart, nil},
+		{"OutputError", "1", mockPartOne, errors.New("output failed")},
+		{"PartError", "3", mockPartOne, nil},
+		{"Success", "1", mockPartOne, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled []error
+
+			mok := mock.NewManager(tt.part, nil, tt.outputErr)
+			err := goaoc.Run("input", tt.partOne, mockPartTwo,
+				goaoc.WithManager(&mok),
+				goaoc.WithOnError(func(err error) { handled = append(handled, err) }))
+
+			if err == nil {
+				if len(handled) != 0 {
+					t.Errorf("Expected no handled errors, but got: %v", handled)
+				}
+
+				return
+			}
+
+			if len(handled) != 1 || handled[0] != err {
+				t.Errorf("Expected the handler to receive %v, but got: %v", err, handled)
+			}
+		})
+	}
+}
+
+type mockSolver struct {
+	calls int
+}
+
+func (s *mockSolver) Part1(input string) string {
+	s.calls++
+
+	return "part one of " + input
+}
+
+func (s *mockSolver) Part2(input string) string {
+	s.calls++
+
+	return "part two of " + input
+}
+
+func TestRunSolver(t *testing.T) {
+	testCases := []struct {
+		name           string
+		part           string
+		expectedOutput string
+	}{
+		{"PartOne", "1", "The challenge result is part one of input\n"},
+		{"PartTwo", "2", "The challenge result is part two of input\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			solver := &mockSolver{}
+			mok := mock.NewManager(tc.part, nil, nil)
+			err := goaoc.RunSolver("input", solver, goaoc.WithManager(&mok))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if output := mok.GetStdout(); output != tc.expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", tc.expectedOutput, output)
+			}
+
+			if solver.calls != 1 {
+				t.Errorf("Expected the solver to be called once, but got %d calls", solver.calls)
+			}
+		})
+	}
+}
+
+func TestRunParts(t *testing.T) {
+	challenges := []goaoc.Challenge[int]{
+		func(_ string) int { return 1 },
+		func(_ string) int { return 2 },
+		func(_ string) int { return 3 },
+	}
+
+	testCases := []struct {
+		name           string
+		part           string
+		options        []goaoc.RunOption
+		expectedOutput string
+		expectErr      string
+	}{
+		{"PartThree", "3", nil, "The challenge result is 3\n", ""},
+		{"AllParts", "all", nil, "The challenge result is 1\nThe challenge result is 2\nThe challenge result is 3\n", ""},
+		{"ReadPartOutOfRange", "4", nil, "", "invalid part: 4. The valid parts are (1/2/3)"},
+		{"OptionPartOutOfRange", "", []goaoc.RunOption{goaoc.WithPart(4)}, "", "invalid part: 4. The valid parts are (1/2/3)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager(tc.part, nil, nil)
+			options := append([]goaoc.RunOption{goaoc.WithManager(&mok)}, tc.options...)
+			err := goaoc.RunParts("input", challenges, options...)
+
+			if tc.expectErr != "" {
+				if err == nil || err.Error() != tc.expectErr {
+					t.Fatalf("Expected error '%s', but got: %v", tc.expectErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if output := mok.GetStdout(); output != tc.expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", tc.expectedOutput, output)
+			}
+		})
+	}
+}
+
+func TestRunParsed(t *testing.T) {
+	t.Run("ParsesOnce", func(t *testing.T) {
+		var parses atomic.Int32
+
+		parse := func(input string) ([]string, error) {
+			parses.Add(1)
+
+			return strings.Split(input, ","), nil
+		}
+
+		mok := mock.NewManager("both", nil, nil)
+		err := goaoc.RunParsed("a,b,c", parse,
+			func(values []string) string { return values[0] },
+			func(values []string) string { return values[2] },
+			goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if parses.Load() != 1 {
+			t.Errorf("Expected input to be parsed once, but it was parsed %d times", parses.Load())
+		}
+
+		expectedOutput := "The challenge result is a\nThe challenge result is c\n"
+		if output := mok.GetStdout(); output != expectedOutput {
+			t.Errorf("Expected output '%s', but got '%s'", expectedOutput, output)
+		}
+	})
+
+	t.Run("NamedInputs", func(t *testing.T) {
+		mok := mock.NewManager("both", nil, nil)
+		err := goaoc.RunParsed("ignored", func(input string) ([]string, error) { return strings.Split(input, ","), nil },
+			func(values []string) string { return strconv.Itoa(len(values)) },
+			func(values []string) string { return values[0] },
+			goaoc.WithManager(&mok),
+			goaoc.WithNamedInput("example", goaoc.WithInputString("x")),
+			goaoc.WithNamedInput("real", goaoc.WithInputString("a,b,c")))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		output := mok.GetStdout()
+		for _, row := range []string{"example  1     1", "example  2     x", "real     1     3", "real     2     a"} {
+			if !strings.Contains(output, row) {
+				t.Errorf("Expected the table to contain '%s', but got:\n%s", row, output)
+			}
+		}
+	})
+
+	t.Run("ParseError", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.RunParsed("input",
+			func(_ string) (int, error) { return 0, errors.New("bad input") },
+			func(value int) int { return value },
+			func(value int) int { return value },
+			goaoc.WithManager(&mok))
+
+		if err == nil || err.Error() != "challenge part 1 failed: bad input" {
+			t.Fatalf("Expected error 'challenge part 1 failed: bad input', but got: %v", err)
+		}
+	})
+}
+
+func TestLinesAndBlocks(t *testing.T) {
+	testCases := []struct {
+		name           string
+		input          string
+		expectedLines  []string
+		expectedBlocks [][]string
+	}{
+		{"Empty", "", []string{}, [][]string{}},
+		{"SingleLine", "abc\n", []string{"abc"}, [][]string{{"abc"}}},
+		{"CRLF", "a\r\nb\r\n", []string{"a", "b"}, [][]string{{"a", "b"}}},
+		{"Blocks", "a\nb\n\nc\n\n\nd\n", []string{"a", "b", "", "c", "", "", "d"}, [][]string{{"a", "b"}, {"c"}, {"d"}}},
+		{"LeadingBlankLine", "\na\n", []string{"", "a"}, [][]string{{"a"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if lines := goaoc.Lines(tc.input); !reflect.DeepEqual(lines, tc.expectedLines) {
+				t.Errorf("Expected lines %q, but got %q", tc.expectedLines, lines)
+			}
+
+			if blocks := goaoc.Blocks(tc.input); !reflect.DeepEqual(blocks, tc.expectedBlocks) {
+				t.Errorf("Expected blocks %q, but got %q", tc.expectedBlocks, blocks)
+			}
+		})
+	}
+}
+
+func TestRunLinesAndBlocks(t *testing.T) {
+	t.Run("RunLines", func(t *testing.T) {
+		mok := mock.NewManager("both", nil, nil)
+		err := goaoc.RunLines("a\nb\nc\n",
+			func(lines []string) string { return lines[0] },
+			func(lines []string) string { return strconv.Itoa(len(lines)) },
+			goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expectedOutput := "The challenge result is a\nThe challenge result is 3\n"
+		if output := mok.GetStdout(); output != expectedOutput {
+			t.Errorf("Expected output '%s', but got '%s'", expectedOutput, output)
+		}
+	})
+
+	t.Run("RunBlocks", func(t *testing.T) {
+		mok := mock.NewManager("2", nil, nil)
+		err := goaoc.RunBlocks("a\nb\n\nc\n", nil,
+			func(blocks [][]string) int { return len(blocks[0])*10 + len(blocks) },
+			goaoc.WithManager(&mok))
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is 22\n" {
+			t.Errorf("Expected output 'The challenge result is 22', but got '%s'", output)
+		}
+	})
+}
+
+func TestRunWithRetries(t *testing.T) {
+	testCases := []struct {
+		name           string
+		retries        int
+		acceptFrom     int
+		expectedCalls  int
+		expectedOutput string
+		expectErr      string
+	}{
+		{"AcceptedFirst", 3, 1, 1, "The challenge result is 1\n", ""},
+		{"AcceptedAfterRetries", 3, 3, 3, "The challenge result is 3\n", ""},
+		{"Exhausted", 2, 5, 3, "", "no answer accepted for part 1 after 3 attempts, last answer was 3"},
+		{"NoRetries", 0, 2, 1, "", "no answer accepted for part 1 after 1 attempts, last answer was 1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			counter := func(_ string) int {
+				calls++
+
+				return calls
+			}
+
+			mok := mock.NewManager("1", nil, nil)
+			err := goaoc.Run("input", counter, mockPartTwo, goaoc.WithManager(&mok),
+				goaoc.WithRetries(tc.retries, func(answer int) bool { return answer >= tc.acceptFrom }))
+
+			if tc.expectErr != "" {
+				var retryErr goaoc.RetryError
+				if err == nil || err.Error() != tc.expectErr || !errors.As(err, &retryErr) {
+					t.Fatalf("Expected RetryError '%s', but got: %v", tc.expectErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if calls != tc.expectedCalls {
+				t.Errorf("Expected %d calls, but got %d", tc.expectedCalls, calls)
+			}
+
+			if output := mok.GetStdout(); output != tc.expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", tc.expectedOutput, output)
+			}
+		})
+	}
+
+	t.Run("NegativeRetries", func(t *testing.T) {
+		err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithRetries(-1, func(_ int) bool { return true }))
+
+		if !errors.Is(err, goaoc.ErrNegativeRetries) {
+			t.Fatalf("Expected ErrNegativeRetries, but got: %v", err)
+		}
+	})
+}
+
+func TestRunWithValidator(t *testing.T) {
+	errTooHigh := errors.New("too high")
+	belowThirty := func(answer int) error {
+		if answer >= 30 {
+			return errTooHigh
+		}
+
+		return nil
+	}
+
+	testCases := []struct {
+		name           string
+		part           string
+		validator      goaoc.RunOption
+		expectedOutput string
+		expectErr      string
+	}{
+		{"Accepted", "2", goaoc.WithValidator(belowThirty), "The challenge result is 24\n", ""},
+		{"Rejected", "1", goaoc.WithValidator(belowThirty), "", "answer 42 for part 1 rejected: too high"},
+		{"TypeMismatch", "1", goaoc.WithValidator(func(_ string) error { return nil }), "", "option WithValidator expects string, but got int"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager(tc.part, nil, nil)
+			err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok), tc.validator)
+
+			if tc.expectErr != "" {
+				if err == nil || err.Error() != tc.expectErr {
+					t.Fatalf("Expected error '%s', but got: %v", tc.expectErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if output := mok.GetStdout(); output != tc.expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", tc.expectedOutput, output)
+			}
+		})
+	}
+
+	t.Run("WrapsValidatorError", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok), goaoc.WithValidator(belowThirty))
+
+		var validationErr goaoc.ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Answer != "42" || !errors.Is(err, errTooHigh) {
+			t.Fatalf("Expected ValidationError wrapping the validator error, but got: %#v", err)
+		}
+	})
+}
+
+func TestRunWithFormatter(t *testing.T) {
+	hex := goaoc.WithFormatter(func(answer int) string { return fmt.Sprintf("%#x", answer) })
+
+	testCases := []struct {
+		name           string
+		formatter      goaoc.RunOption
+		expectedOutput string
+		expectErr      string
+	}{
+		{"Hex", hex, "The challenge result is 0x2a\n", ""},
+		{"Padded", goaoc.WithFormatter(func(answer int) string { return fmt.Sprintf("%06d", answer) }), "The challenge result is 000042\n", ""},
+		{"TypeMismatch", goaoc.WithFormatter(func(answer string) string { return answer }), "", "option WithFormatter expects string, but got int"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager("1", nil, nil)
+			err := goaoc.Run("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok), tc.formatter)
+
+			if tc.expectErr != "" {
+				if err == nil || err.Error() != tc.expectErr {
+					t.Fatalf("Expected error '%s', but got: %v", tc.expectErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if output := mok.GetStdout(); output != tc.expectedOutput {
+				t.Errorf("Expected output '%s', but got '%s'", tc.expectedOutput, output)
+			}
+		})
+	}
+}
+
+func TestRunWithRecoverDisabled(t *testing.T) {
+	defer func() {
+		if value := recover(); value == nil {
+			t.Error("Expected the challenge panic to reach the caller")
+		}
+	}()
+
+	mok := mock.NewManager("1", nil, nil)
+	_ = goaoc.Run("input", mockPanickingPart, mockPartTwo, goaoc.WithManager(&mok), goaoc.WithRecover(false))
+
+	t.Fatal("Expected Run to panic")
+}
+
+func TestRunResult(t *testing.T) {
+	testCases := []struct {
+		name   string
+		part   string
+		expect goaoc.Part
+		answer int
+	}{
+		{"PartOne", "1", 1, 42},
+		{"PartTwo", "2", 2, 24},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mok := mock.NewManager(tc.part, nil, nil)
+			result, err := goaoc.RunResult("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok))
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result.Answer != tc.answer || result.Part != tc.expect || result.Duration < 0 {
+				t.Errorf("Unexpected result: %+v", result)
+			}
+		})
+	}
+
+	t.Run("OutputError", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, errors.New("output failed"))
+		result, err := goaoc.RunResult("input", mockPartOne, mockPartTwo, goaoc.WithManager(&mok))
+
+		if err == nil || err.Error() != "output failed" {
+			t.Fatalf("Expected error 'output failed', but got: %v", err)
+		}
+
+		if result.Answer != 42 {
+			t.Errorf("Expected the computed answer to be returned along with the error, but got %+v", result)
+		}
+	})
+}
+
 func mockPartOne(_ string) int {
 	return 42
 }
@@ -117,3 +1149,9 @@ func mockPartOne(_ string) int {
 func mockPartTwo(_ string) int {
 	return 24
 }
+
+func mockPanickingPart(input string) int {
+	var values []int
+
+	return values[len(input)]
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// WithSample creates a RunOption that switches the input to the sample input of the puzzle, so the
+// example can be checked without editing the code. It is also enabled by the -sample flag of the
+// console manager. The sample is read, like with WithInputFile, from the first existing file of:
+// sample.txt in the working directory and, when the day is known from WithDay, an input option such as
+// WithInputDownload, or the directory names, inputs/day<DD>_sample.txt and day<DD>/sample.txt in the module root.
+// When the year is known too, the first example of the puzzle page is used last: the examples are saved in the
+// InputCache when an input is downloaded, or, if needed, downloaded by WithSample with the session.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDiscovery(2024, 5), WithSample())
+func WithSample() RunOption {
+	return func(options *runOptions) error {
+		options.sample = true
+
+		return nil
+	}
+}
+
+// loadSample reads the sample input.
+func (o *runOptions) loadSample(ctx context.Context) (string, error) {
+	path, err := o.samplePath(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fileInput(os.ReadFile(path))
+}
+
+// samplePath returns the path of the sample input. When none is found and the year and day are known, the
+// examples of the puzzle page are downloaded first, see WithSample.
+func (o *runOptions) samplePath(ctx context.Context) (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	candidates := sampleCandidates(dir, o.day)
+	if o.year != 0 && o.day != 0 {
+		cache, err := o.inputCache()
+		if err != nil {
+			return "", err
+		}
+
+		candidates = append(candidates, cache.ExamplePath(o.year, o.day, 1))
+	}
+
+	path, err := findFile(candidates)
+	if errors.Is(err, ErrInputFileNotFound) {
+		if downloadErr := o.downloadExamples(ctx); downloadErr != nil {
+			return "", fmt.Errorf("%w: %w", err, downloadErr)
+		}
+
+		path, err = findFile(candidates)
+	}
+
+	return path, err
+}
+
+// sampleCandidates returns the paths where the sample input is looked for, in order, from the working
+// directory dir. The conventional paths in the module root are only included when the day is known.
+func sampleCandidates(dir string, day int) []string {
+	candidates := []string{filepath.Join(dir, "sample.txt")}
+
+	if day > 0 {
+		root := moduleRoot(dir)
+		candidates = append(candidates,
+			filepath.Join(root, "inputs", fmt.Sprintf("day%02d_sample.txt", day)),
+			filepath.Join(root, fmt.Sprintf("day%02d", day), "sample.txt"))
+	}
+
+	return candidates
+}
+
+// sampleInConsole reports whether the -sample flag is set, when reader is a DefaultConsoleManager.
+func sampleInConsole(reader InputReader) (bool, error) {
+	switch console := reader.(type) {
+	case DefaultConsoleManager:
+		return getSampleInFlag(console.Env)
+	case *DefaultConsoleManager:
+		return getSampleInFlag(console.Env)
+	default:
+		return false, nil
+	}
+}
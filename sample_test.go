@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func TestSampleCandidates(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "day05")
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/aoc\n")
+
+	testCases := []struct {
+		name     string
+		day      int
+		expected []string
+	}{
+		{"UnknownDay", 0, []string{filepath.Join(dir, "sample.txt")}},
+		{"KnownDay", 5, []string{
+			filepath.Join(dir, "sample.txt"),
+			filepath.Join(root, "inputs", "day05_sample.txt"),
+			filepath.Join(root, "day05", "sample.txt"),
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			candidates := sampleCandidates(dir, tc.day)
+			if len(candidates) != len(tc.expected) {
+				t.Fatalf("Expected candidates %v, but got %v", tc.expected, candidates)
+			}
+
+			for i := range candidates {
+				if candidates[i] != tc.expected[i] {
+					t.Errorf("Expected candidates %v, but got %v", tc.expected, candidates)
+				}
+			}
+		})
+	}
+}
+
+func TestGetSampleInFlag(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		expected  bool
+		expectErr bool
+	}{
+		{"NotSet", []string{"-part=1"}, false, false},
+		{"Set", []string{"-part", "2", "-sample"}, true, false},
+		{"DoubleDash", []string{"--sample"}, true, false},
+		{"Value", []string{"-sample=false"}, false, false},
+		{"AfterTerminator", []string{"--", "-sample"}, false, false},
+		{"UnknownFlags", []string{"-year=2024", "-sample"}, true, false},
+		{"InvalidValue", []string{"-sample=maybe"}, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sample, err := getSampleInFlag(Env{Args: tc.args})
+			if (err != nil) != tc.expectErr || sample != tc.expected {
+				t.Errorf("Expected %v (error: %v), but got %v (err: %v)", tc.expected, tc.expectErr, sample, err)
+			}
+		})
+	}
+}
+
+func TestInjectOptionsWithSampleFlag(t *testing.T) {
+	opts := runOptions{reader: DefaultConsoleManager{Env: Env{Args: []string{"-part=1", "-sample"}}}}
+
+	if err := injectOptions(&opts, 2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !opts.sample || opts.part != 1 {
+		t.Errorf("Expected sample mode for part 1, but got sample=%v for part %d", opts.sample, opts.part)
+	}
+}
+
+func TestLoadSampleNotFound(t *testing.T) {
+	opts := runOptions{sample: true, day: 5}
+
+	if _, err := opts.loadInput(context.Background()); !errors.Is(err, ErrInputFileNotFound) {
+		t.Errorf("Expected ErrInputFileNotFound, but got: %v", err)
+	}
+}
+
+func TestLoadSampleDownloadsExamples(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	downloads := 0
+	server := newInputServer(t, http.StatusOK, &downloads)
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir(), year: 2024, day: 7}
+
+	if sample, err := opts.loadSample(context.Background()); err != nil || sample != "1 2\n3 < 4" {
+		t.Errorf("Expected the first example of the puzzle page, but got %q (err: %v)", sample, err)
+	}
+
+	if downloads != 0 {
+		t.Errorf("Expected the input not to be downloaded, but it was downloaded %d times", downloads)
+	}
+
+	opts.offline = true
+	opts.day = 8
+
+	if _, err := opts.loadSample(context.Background()); !errors.Is(err, ErrInputFileNotFound) {
+		t.Errorf("Expected ErrInputFileNotFound, but got: %v", err)
+	}
+}
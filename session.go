@@ -0,0 +1,307 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// keyringService is the service under which the session is stored in the OS keyring.
+	keyringService = "goaoc"
+
+	// keyringAccount is the account under which the session is stored in the OS keyring.
+	keyringAccount = "session"
+)
+
+// Session returns the adventofcode.com session cookie used to download inputs, as resolved by ResolveSession.
+// It returns ErrMissingSession if there is none.
+//
+// Example:
+//
+//	session, err := Session()
+func Session() (string, error) {
+	session, _, err := ResolveSession()
+
+	return session, err
+}
+
+// ResolveSession returns the adventofcode.com session cookie used to download inputs, along with a description
+// of its source, such as "AOC_SESSION environment variable", so a wrong account can be tracked down.
+// Like other Advent of Code tools, so they can share the session, it looks in order for:
+//
+//   - the AOC_SESSION environment variable;
+//   - the GOAOC_SESSION environment variable;
+//   - the goaoc/session file in the user configuration directory, such as ~/.config/goaoc/session on Linux;
+//   - the OS keyring, where SetSession stores it.
+//
+// A session given with WithSession takes precedence over all of them. ResolveSession returns
+// ErrMissingSession, listing the sources, if none holds a session.
+func ResolveSession() (session, source string, err error) {
+	return resolveSession("", "")
+}
+
+// ResolveProfileSession works like ResolveSession, but for the session of the named profile, see WithProfile.
+// The session of a profile is looked for, in order, in:
+//
+//   - the GOAOC_SESSION_<PROFILE> environment variable, with the profile name in upper case and dashes
+//     replaced by underscores, e.g. GOAOC_SESSION_WORK;
+//   - the goaoc/sessions/<profile> file in the user configuration directory;
+//   - the OS keyring, where SetProfileSession stores it.
+//
+// The empty profile is the default one, resolved by ResolveSession.
+func ResolveProfileSession(profile string) (session, source string, err error) {
+	if err := validateProfile(profile); err != nil {
+		return "", "", err
+	}
+
+	return resolveSession("", profile)
+}
+
+// resolveSession resolves the session of profile like ResolveProfileSession, with explicit, when not empty,
+// taking precedence.
+func resolveSession(explicit, profile string) (session, source string, err error) {
+	if explicit != "" {
+		return explicit, "WithSession option", nil
+	}
+
+	variables := []string{"AOC_SESSION", "GOAOC_SESSION"}
+	if profile != "" {
+		variables = []string{"GOAOC_SESSION_" + strings.ToUpper(strings.ReplaceAll(profile, "-", "_"))}
+	}
+
+	for _, name := range variables {
+		if session := strings.TrimSpace(os.Getenv(name)); session != "" {
+			return session, name + " environment variable", nil
+		}
+	}
+
+	path, err := sessionFile(profile)
+	if err != nil {
+		return "", "", err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", "", err
+	}
+
+	if session := strings.TrimSpace(string(content)); session != "" {
+		return session, path + " file", nil
+	}
+
+	session, err = keyringSession(keyringAccountOf(profile))
+	if err != nil {
+		return "", "", err
+	}
+
+	if session != "" {
+		return session, "keyring", nil
+	}
+
+	return "", "", fmt.Errorf("%w: looked for %s, %s and the keyring", ErrMissingSession, strings.Join(variables, ", "), path)
+}
+
+// sessionFile returns the path of the session file of profile, in the user configuration directory.
+func sessionFile(profile string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	if profile != "" {
+		return filepath.Join(dir, "goaoc", "sessions", profile), nil
+	}
+
+	return filepath.Join(dir, "goaoc", "session"), nil
+}
+
+// keyringAccountOf returns the keyring account of the session of profile.
+func keyringAccountOf(profile string) string {
+	if profile != "" {
+		return keyringAccount + "/" + profile
+	}
+
+	return keyringAccount
+}
+
+// WithSession creates a RunOption that sets the adventofcode.com session cookie used to download inputs,
+// taking precedence over the sources of ResolveSession.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 7), WithSession(cookie))
+func WithSession(session string) RunOption {
+	return func(options *runOptions) error {
+		options.session = session
+
+		return nil
+	}
+}
+
+// SetSession stores the adventofcode.com session cookie in the OS keyring, so it does not have to be kept
+// in plain text in an environment variable or a file. The keyring is the macOS Keychain, through the
+// security command, the Secret Service on Linux, through the secret-tool command of libsecret, or the Windows
+// Credential Manager, through the password vault of Windows PowerShell. Other systems fail with
+// ErrKeyringUnsupported.
+//
+// Example:
+//
+//	err := SetSession("53616c7465645f5f...")
+func SetSession(session string) error {
+	return SetProfileSession("", session)
+}
+
+// SetProfileSession works like SetSession, but stores the session of the named profile, see WithProfile.
+//
+// Example:
+//
+//	err := SetProfileSession("work", "53616c7465645f5f...")
+func SetProfileSession(profile, session string) error {
+	if err := validateProfile(profile); err != nil {
+		return err
+	}
+
+	account := keyringAccountOf(profile)
+	if err := systemKeyring(account).set(session); err != nil {
+		return err
+	}
+
+	keyringSessions.Store(account, session)
+
+	return nil
+}
+
+// keyringSessions holds the sessions read from the keyring, by account, so the keyring command runs once per
+// process rather than for every request.
+var keyringSessions sync.Map
+
+// keyringSession returns the session stored in the keyring of the running OS under account, or an empty string
+// if there is none, reading the keyring once per process.
+func keyringSession(account string) (string, error) {
+	if value, ok := keyringSessions.Load(account); ok {
+		if session, ok := value.(string); ok {
+			return session, nil
+		}
+	}
+
+	session, err := systemKeyring(account).get()
+	if err != nil {
+		return "", err
+	}
+
+	keyringSessions.Store(account, session)
+
+	return session, nil
+}
+
+// keyring stores the session through the commands of the OS keyring.
+type keyring struct {
+	// lookup is the command printing the stored session.
+	lookup []string
+
+	// store is the command storing the session read from its stdin.
+	store []string
+
+	// stdin returns the stdin of store for the session, when it is not the session itself.
+	stdin func(session string) string
+}
+
+// systemKeyring returns the keyring of the running OS, storing the session under account.
+func systemKeyring(account string) keyring {
+	switch runtime.GOOS {
+	case "darwin":
+		return keyring{
+			lookup: []string{"security", "find-generic-password", "-s", keyringService, "-a", account, "-w"},
+			// security prompts on the terminal for a password it is not given, so the command adding it is
+			// read from stdin instead, keeping the session out of the arguments seen by other processes.
+			store: []string{"security", "-i"},
+			stdin: func(session string) string {
+				return fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+					strconv.Quote(keyringService), strconv.Quote(account), strconv.Quote(session))
+			},
+		}
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return keyring{
+			lookup: []string{"secret-tool", "lookup", "service", keyringService, "account", account},
+			store:  []string{"secret-tool", "store", "--label=goaoc session", "service", keyringService, "account", account},
+		}
+	case "windows":
+		// The password vault of the Windows Runtime stores its credentials in the Credential Manager. The account
+		// is made of letters, digits, dashes, underscores and a slash, see validateProfile, so it can be quoted.
+		vault := "[void][Windows.Security.Credentials.PasswordVault,Windows.Security.Credentials,ContentType=WindowsRuntime]; " +
+			"$vault = New-Object Windows.Security.Credentials.PasswordVault; "
+
+		return keyring{
+			lookup: powershell(vault + fmt.Sprintf("$credential = $vault.Retrieve('%s', '%s'); "+
+				"$credential.RetrievePassword(); $credential.Password", keyringService, account)),
+			store: powershell(vault + fmt.Sprintf("$vault.Add((New-Object Windows.Security.Credentials.PasswordCredential("+
+				"'%s', '%s', [Console]::In.ReadToEnd().Trim())))", keyringService, account)),
+		}
+	default:
+		return keyring{}
+	}
+}
+
+// powershell returns the command running script with Windows PowerShell, which can use the Windows Runtime.
+func powershell(script string) []string {
+	return []string{"powershell", "-NoProfile", "-NonInteractive", "-Command", script}
+}
+
+// get returns the stored session, or an empty string if there is none.
+func (k keyring) get() (string, error) {
+	if len(k.lookup) == 0 {
+		return "", nil
+	}
+
+	var stdout bytes.Buffer
+
+	cmd := exec.CommandContext(context.Background(), k.lookup[0], k.lookup[1:]...)
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) || errors.Is(err, exec.ErrNotFound) {
+			// The keyring has no session, or is not installed.
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// set stores the session.
+func (k keyring) set(session string) error {
+	if len(k.store) == 0 {
+		return ErrKeyringUnsupported
+	}
+
+	stdin := session
+	if k.stdin != nil {
+		stdin = k.stdin(session)
+	}
+
+	cmd := exec.CommandContext(context.Background(), k.store[0], k.store[1:]...)
+	cmd.Stdin = strings.NewReader(stdin)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return KeyringError{Output: strings.TrimSpace(string(output)), Err: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// fakeKeyring installs a fake keyring command on PATH, storing the session in a file of a temporary directory.
+func fakeKeyring(t *testing.T) keyring {
+	t.Helper()
+
+	dir := t.TempDir()
+	script := "#!/bin/sh\nif [ \"$1\" = store ]; then cat > " + filepath.Join(dir, "secret") + "; else cat " + filepath.Join(dir, "secret") + "; fi\n"
+
+	if err := os.WriteFile(filepath.Join(dir, "fake-keyring"), []byte(script), 0o700); err != nil {
+		t.Fatalf("Unexpected error writing the fake keyring: %v", err)
+	}
+
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	return keyring{lookup: []string{"fake-keyring", "lookup"}, store: []string{"fake-keyring", "store"}}
+}
+
+func TestKeyring(t *testing.T) {
+	k := fakeKeyring(t)
+
+	if session, err := k.get(); err != nil || session != "" {
+		t.Fatalf("Expected no session, but got %q (err: %v)", session, err)
+	}
+
+	if err := k.set("secret"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if session, err := k.get(); err != nil || session != "secret" {
+		t.Errorf("Expected the stored session, but got %q (err: %v)", session, err)
+	}
+}
+
+func TestKeyringStdin(t *testing.T) {
+	k := fakeKeyring(t)
+	k.stdin = strings.ToUpper
+
+	if err := k.set("secret"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if session, err := k.get(); err != nil || session != "SECRET" {
+		t.Errorf("Expected the stdin of the store command, but got %q (err: %v)", session, err)
+	}
+}
+
+func TestKeyringSessionMemoized(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the fake secret-tool command only replaces the keyring of Linux")
+	}
+
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho lookup >> " + filepath.Join(dir, "lookups") + "\necho secret\n"
+
+	if err := os.WriteFile(filepath.Join(dir, "secret-tool"), []byte(script), 0o700); err != nil {
+		t.Fatalf("Unexpected error writing the fake keyring: %v", err)
+	}
+
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	for range 3 {
+		if session, err := keyringSession("memoized"); err != nil || session != "secret" {
+			t.Fatalf("Expected the session of the keyring, but got %q (err: %v)", session, err)
+		}
+	}
+
+	if lookups, err := os.ReadFile(filepath.Join(dir, "lookups")); err != nil || string(lookups) != "lookup\n" {
+		t.Errorf("Expected the keyring to be read once, but got %q (err: %v)", lookups, err)
+	}
+}
+
+func TestKeyringErrors(t *testing.T) {
+	if err := (keyring{}).set("secret"); !errors.Is(err, ErrKeyringUnsupported) {
+		t.Errorf("Expected ErrKeyringUnsupported, but got: %v", err)
+	}
+
+	var keyringErr KeyringError
+	if err := (keyring{store: []string{"false"}}).set("secret"); !errors.As(err, &keyringErr) {
+		t.Errorf("Expected KeyringError, but got: %v", err)
+	}
+
+	if session, err := (keyring{lookup: []string{"goaoc-missing-keyring"}}).get(); err != nil || session != "" {
+		t.Errorf("Expected a missing keyring to have no session, but got %q (err: %v)", session, err)
+	}
+}
+
+func TestSessionFromEnv(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "from env")
+
+	if session, err := Session(); err != nil || session != "from env" {
+		t.Errorf("Expected the session of GOAOC_SESSION, but got %q (err: %v)", session, err)
+	}
+}
+
+func TestResolveSession(t *testing.T) {
+	config := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", config)
+	t.Setenv("HOME", config)
+	t.Setenv("AOC_SESSION", "")
+	t.Setenv("GOAOC_SESSION", "")
+
+	path, err := sessionFile("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	writeFile(t, path, "from file\n")
+
+	steps := []struct {
+		name           string
+		setup          func()
+		explicit       string
+		expectedResult string
+		expectedSource string
+	}{
+		{"file", func() {}, "", "from file", path + " file"},
+		{"GOAOC_SESSION", func() { t.Setenv("GOAOC_SESSION", "from goaoc") }, "", "from goaoc", "GOAOC_SESSION environment variable"},
+		{"AOC_SESSION", func() { t.Setenv("AOC_SESSION", "from aoc") }, "", "from aoc", "AOC_SESSION environment variable"},
+		{"WithSession", func() {}, "explicit", "explicit", "WithSession option"},
+	}
+
+	for _, step := range steps {
+		step.setup()
+
+		session, source, err := resolveSession(step.explicit, "")
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+
+		if session != step.expectedResult || source != step.expectedSource {
+			t.Errorf("%s: expected %q from %q, but got %q from %q", step.name, step.expectedResult, step.expectedSource, session, source)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"slices"
+	"time"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+// SolveTime is the time taken to solve a part of a puzzle, from when its input was first downloaded until its
+// right answer was submitted with Submit or WithAutoSubmit, as recorded in the InputCache.
+type SolveTime struct {
+	Part Part
+
+	// Unlocked is when the puzzle unlocked, at midnight EST.
+	Unlocked time.Time
+
+	// Fetched is when the input was first fetched by a CachedProvider, such as the one of WithInputDownload,
+	// or the zero time if it is unknown.
+	Fetched time.Time
+
+	// Solved is when the right answer was submitted.
+	Solved time.Time
+}
+
+// Duration returns the time taken to solve the part since the input was fetched, or since the puzzle unlocked
+// when it is not known when the input was fetched.
+func (s SolveTime) Duration() time.Duration {
+	if s.Fetched.IsZero() {
+		return s.SinceUnlock()
+	}
+
+	return s.Solved.Sub(s.Fetched)
+}
+
+// SinceUnlock returns the time taken to solve the part since the puzzle unlocked, as ranked by the leaderboards.
+func (s SolveTime) SinceUnlock() time.Duration {
+	return s.Solved.Sub(s.Unlocked)
+}
+
+// SolveTimes returns the SolveTime of each solved part of the puzzle of the given year and day, in the order of
+// the parts. Parts not solved yet, or solved without goaoc, are missing.
+func (c InputCache) SolveTimes(year, day int) ([]SolveTime, error) {
+	log, err := c.loadSubmissions(year, day)
+	if err != nil {
+		return nil, err
+	}
+
+	times := make([]SolveTime, 0, len(log.Parts))
+
+	for part, ledger := range log.Parts {
+		if ledger.SolvedAt.IsZero() {
+			continue
+		}
+
+		times = append(times, SolveTime{Part: part, Unlocked: aocapi.UnlockTime(year, day), Fetched: log.FetchedAt, Solved: ledger.SolvedAt})
+	}
+
+	slices.SortFunc(times, func(a, b SolveTime) int { return int(a.Part - b.Part) })
+
+	return times, nil
+}
+
+// SolveTimes returns the SolveTime of each solved part of the puzzle of the given year and day, from the
+// DefaultInputCache.
+//
+// Example:
+//
+//	times, err := SolveTimes(2024, 7)
+//	for _, t := range times {
+//	    fmt.Printf("part %d: %s\n", t.Part, t.Duration())
+//	}
+func SolveTimes(year, day int) ([]SolveTime, error) {
+	cache, err := DefaultInputCache()
+	if err != nil {
+		return nil, err
+	}
+
+	return cache.SolveTimes(year, day)
+}
+
+// recordFetch records when the input of the puzzle of the given year and day is fetched, unless it was already.
+func (c InputCache) recordFetch(year, day int) error {
+	log, err := c.loadSubmissions(year, day)
+	if err != nil || !log.FetchedAt.IsZero() {
+		return err
+	}
+
+	log.Session = c.Session
+	log.FetchedAt = time.Now().UTC()
+
+	return c.storeSubmissions(year, day, log)
+}
+
+// solveTime returns the SolveTime of part of the puzzle of the given year and day, and reports false if it
+// is not solved.
+func (c InputCache) solveTime(year, day int, part Part) (SolveTime, bool, error) {
+	times, err := c.SolveTimes(year, day)
+	if err != nil {
+		return SolveTime{}, false, err
+	}
+
+	for _, t := range times {
+		if t.Part == part {
+			return t, true, nil
+		}
+	}
+
+	return SolveTime{}, false, nil
+}
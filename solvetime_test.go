@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+func TestSolveTimes(t *testing.T) {
+	cache := InputCache{Dir: t.TempDir()}
+	provider := CachedProvider(cache, InputProviderFunc(func(context.Context, int, int) (string, error) {
+		return "input", nil
+	}))
+
+	if _, err := provider.Fetch(context.Background(), 2024, 7); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	log, err := cache.loadSubmissions(2024, 7)
+	if err != nil || log.FetchedAt.IsZero() {
+		t.Fatalf("Expected the fetch to be recorded, but got %+v (err: %v)", log, err)
+	}
+
+	// The input is fetched again, but the first fetch is kept.
+	if err := cache.Invalidate(2024, 7); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if _, err := provider.Fetch(context.Background(), 2024, 7); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	for _, submission := range []struct {
+		part    Part
+		verdict Verdict
+	}{{2, Correct}, {1, TooLow}, {1, Correct}, {2, Correct}} {
+		if err := cache.recordSubmission(2024, 7, submission.part, "42", Submission{Verdict: submission.verdict}); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+	}
+
+	times, err := cache.SolveTimes(2024, 7)
+	if err != nil || len(times) != 2 {
+		t.Fatalf("Expected 2 solve times, but got %+v (err: %v)", times, err)
+	}
+
+	for i, solveTime := range times {
+		if solveTime.Part != Part(i+1) || !solveTime.Fetched.Equal(log.FetchedAt) || solveTime.Solved.Before(log.FetchedAt) {
+			t.Errorf("Expected part %d solved after %v, but got %+v", i+1, log.FetchedAt, solveTime)
+		}
+
+		if solveTime.Duration() < 0 || solveTime.Duration() > time.Minute {
+			t.Errorf("Expected part %d to be solved right after the fetch, but got %s", i+1, solveTime.Duration())
+		}
+	}
+}
+
+func TestSolveTimeDuration(t *testing.T) {
+	unlocked := aocapi.UnlockTime(2024, 7)
+	solveTime := SolveTime{Part: 1, Unlocked: unlocked, Solved: unlocked.Add(time.Hour)}
+
+	if solveTime.Duration() != time.Hour || solveTime.SinceUnlock() != time.Hour {
+		t.Errorf("Expected 1h since the unlock without a fetch, but got %s", solveTime.Duration())
+	}
+
+	solveTime.Fetched = unlocked.Add(20 * time.Minute)
+
+	if solveTime.Duration() != 40*time.Minute || solveTime.SinceUnlock() != time.Hour {
+		t.Errorf("Expected 40m since the fetch and 1h since the unlock, but got %s and %s", solveTime.Duration(), solveTime.SinceUnlock())
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+)
+
+// WithInputSSH creates a RunOption that reads the challenge input from a file of a remote machine, given as
+// an ssh://[user@]host[:port]/path URL, such as ssh://me@devbox/home/me/aoc/inputs/2024/day07.txt. A path
+// starting with /~/ is relative to the remote home directory.
+//
+// The file is read by the ssh command of the local machine, so its configuration (~/.ssh/config) applies,
+// and the authentication is left to the SSH agent or the keys of the local user: password prompts are
+// disabled. The input is trimmed like with WithInputFile, and a failure of the ssh command is returned as
+// an SSHError. A malformed URL fails at option time with ErrInvalidSSHURL.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputSSH("ssh://me@devbox/~/aoc/inputs/2024/day07.txt"))
+func WithInputSSH(rawURL string) RunOption {
+	return func(options *runOptions) error {
+		args, err := sshArgs(rawURL)
+		if err != nil {
+			return err
+		}
+
+		options.input = func(ctx context.Context) (string, error) {
+			return readSSH(ctx, args)
+		}
+		options.inputPath = ""
+
+		return nil
+	}
+}
+
+// sshArgs returns the arguments of the ssh command printing the remote file of the ssh:// URL rawURL.
+func sshArgs(rawURL string) ([]string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSSHURL, err)
+	}
+
+	if parsed.Scheme != "ssh" || parsed.Hostname() == "" || strings.Trim(parsed.Path, "/") == "" {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSSHURL, rawURL)
+	}
+
+	args := []string{"-o", "BatchMode=yes"}
+	if port := parsed.Port(); port != "" {
+		args = append(args, "-p", port)
+	}
+
+	destination := parsed.Hostname()
+	if parsed.User != nil {
+		destination = parsed.User.Username() + "@" + destination
+	}
+
+	return append(args, "--", destination, "cat -- "+remotePath(parsed.Path)), nil
+}
+
+// remotePath quotes path for the remote shell, keeping a leading ~/ unquoted so it is expanded to the home directory.
+func remotePath(path string) string {
+	if rest, ok := strings.CutPrefix(path, "/~/"); ok {
+		return "~/" + shellQuote(rest)
+	}
+
+	return shellQuote(path)
+}
+
+// shellQuote quotes s as a single word for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// readSSH runs the ssh command with args and returns its output, trimmed like a file input.
+func readSSH(ctx context.Context, args []string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "ssh", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", SSHError{Output: strings.TrimSpace(stderr.String()), Err: err}
+	}
+
+	return TrimTrailingNewline(stdout.String()), nil
+}
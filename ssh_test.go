@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSSHArgs(t *testing.T) {
+	testCases := []struct {
+		url       string
+		expected  []string
+		expectErr error
+	}{
+		{"ssh://me@devbox/home/me/day07.txt", []string{"-o", "BatchMode=yes", "--", "me@devbox", "cat -- '/home/me/day07.txt'"}, nil},
+		{"ssh://devbox:2222/~/it's/day07.txt", []string{"-o", "BatchMode=yes", "-p", "2222", "--", "devbox", `cat -- ~/'it'\''s/day07.txt'`}, nil},
+		{"https://devbox/day07.txt", nil, ErrInvalidSSHURL},
+		{"ssh://devbox/", nil, ErrInvalidSSHURL},
+		{"ssh:///day07.txt", nil, ErrInvalidSSHURL},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			args, err := sshArgs(tc.url)
+			if !errors.Is(err, tc.expectErr) || !reflect.DeepEqual(args, tc.expected) {
+				t.Errorf("Expected %q (error: %v), but got %q (err: %v)", tc.expected, tc.expectErr, args, err)
+			}
+		})
+	}
+}
+
+func TestWithInputSSH(t *testing.T) {
+	dir := t.TempDir()
+	script := "#!/bin/sh\nfor last; do :; done\nif [ \"$last\" = \"cat -- '/missing.txt'\" ]; then echo 'No such file' >&2; exit 1; fi\nprintf '1 2 3\\n'\n"
+
+	if err := os.WriteFile(filepath.Join(dir, "ssh"), []byte(script), 0o700); err != nil {
+		t.Fatalf("Unexpected error writing the fake ssh: %v", err)
+	}
+
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	var opts runOptions
+	if err := WithInputSSH("ssh://me@devbox/day07.txt")(&opts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if input, err := opts.input(context.Background()); err != nil || input != "1 2 3" {
+		t.Errorf("Expected the remote input, but got %q (err: %v)", input, err)
+	}
+
+	if err := WithInputSSH("ssh://me@devbox/missing.txt")(&opts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var sshErr SSHError
+	if _, err := opts.input(context.Background()); !errors.As(err, &sshErr) || sshErr.Output != "No such file" {
+		t.Errorf("Expected an SSHError with the output of ssh, but got: %v", err)
+	}
+}
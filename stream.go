@@ -0,0 +1,102 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"iter"
+	"os"
+	"slices"
+	"strings"
+)
+
+// maxStreamLine is the maximum length of a line read by RunStream.
+const maxStreamLine = 64 * 1024 * 1024
+
+// RunStream works like Run, but hands the challenges the lines of the input as they are read from the stream
+// returned by open, so the input is never held in memory as a whole. open is called once by each executed part,
+// and the stream is closed when the part returns. A failure to open or read the stream is returned wrapped in
+// a ChallengeError.
+//
+// As the input is not loaded, input options, normalizers, WithSample and the -sample flag do not apply to it,
+// and the input hash of the run metadata is the one of an empty input.
+//
+// Example:
+//
+//	err := RunStream(StreamFile("huge.txt"), part1Func, part2Func)
+func RunStream[T comparable](open func() (io.ReadCloser, error), partOne, partTwo StreamChallenge[T], options ...RunOption) error {
+	withStream := func(solve StreamChallenge[T]) ChallengeE[T] {
+		if solve == nil {
+			return nil
+		}
+
+		return func(string) (answer T, err error) {
+			stream, err := open()
+			if err != nil {
+				return answer, err
+			}
+			defer stream.Close()
+
+			var scanErr error
+
+			answer = solve(streamLines(stream, &scanErr))
+
+			return answer, scanErr
+		}
+	}
+
+	// The stream option comes last, so the input options and WithSample are overridden.
+	options = append(slices.Clip(options), withStreamInput())
+	_, err := run(context.Background(), []ChallengeE[T]{withStream(partOne), withStream(partTwo)}, options...)
+
+	return err
+}
+
+// withStreamInput creates a RunOption that leaves the input to the challenges of RunStream: no input is loaded,
+// and neither the sample mode of WithSample nor of the -sample flag applies.
+func withStreamInput() RunOption {
+	return func(options *runOptions) error {
+		options.stream = true
+		options.sample = false
+		options.input, options.inputPath = nil, ""
+
+		return nil
+	}
+}
+
+// StreamFile returns a stream opener for RunStream that opens the file at path.
+//
+// Example:
+//
+//	err := RunStream(StreamFile("input.txt"), part1Func, part2Func)
+func StreamFile(path string) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return file, nil
+	}
+}
+
+// streamLines returns the lines read from r, without their "\n" or "\r\n" line breaks. A read error
+// stops the iteration and is stored in errp.
+func streamLines(r io.Reader, errp *error) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, maxStreamLine)
+
+		for scanner.Scan() {
+			if !yield(strings.TrimSuffix(scanner.Text(), "\r")) {
+				return
+			}
+		}
+
+		*errp = scanner.Err()
+	}
+}
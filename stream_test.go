@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"iter"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hvpaiva/goaoc"
+	"github.com/hvpaiva/goaoc/mock"
+)
+
+func TestRunStream(t *testing.T) {
+	countLines := func(lines iter.Seq[string]) int {
+		count := 0
+		for range lines {
+			count++
+		}
+
+		return count
+	}
+
+	firstLine := func(lines iter.Seq[string]) int {
+		for line := range lines {
+			return len(line)
+		}
+
+		return 0
+	}
+
+	t.Run("File", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "input.txt")
+		if err := os.WriteFile(path, []byte("abc\r\nde\nf\n"), 0o600); err != nil {
+			t.Fatalf("Unexpected error writing the input: %v", err)
+		}
+
+		mok := mock.NewManager("both", nil, nil)
+		if err := goaoc.RunStream(goaoc.StreamFile(path), countLines, firstLine, goaoc.WithManager(&mok)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expectedOutput := "The challenge result is 3\nThe challenge result is 3\n"
+		if output := mok.GetStdout(); output != expectedOutput {
+			t.Errorf("Expected output '%s', but got '%s'", expectedOutput, output)
+		}
+	})
+
+	t.Run("Generated", func(t *testing.T) {
+		open := func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(strings.Repeat("line\n", 1000))), nil
+		}
+
+		mok := mock.NewManager("1", nil, nil)
+		if err := goaoc.RunStream(open, countLines, nil, goaoc.WithManager(&mok)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is 1000\n" {
+			t.Errorf("Expected output 'The challenge result is 1000', but got '%s'", output)
+		}
+	})
+
+	t.Run("Sample", func(t *testing.T) {
+		open := func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader("a\nb\n")), nil
+		}
+
+		mok := mock.NewManager("1", nil, nil)
+		if err := goaoc.RunStream(open, countLines, nil, goaoc.WithManager(&mok), goaoc.WithSample()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if output := mok.GetStdout(); output != "The challenge result is 2\n" {
+			t.Errorf("Expected output 'The challenge result is 2', but got '%s'", output)
+		}
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		mok := mock.NewManager("1", nil, nil)
+		err := goaoc.RunStream(goaoc.StreamFile(filepath.Join(t.TempDir(), "missing.txt")), countLines, nil, goaoc.WithManager(&mok))
+
+		var challengeErr goaoc.ChallengeError
+		if !errors.As(err, &challengeErr) || !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected a ChallengeError wrapping fs.ErrNotExist, but got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hvpaiva/goaoc/internal/atomicfile"
+)
+
+// submissionLog records the submissions of answers to a puzzle, stored in the InputCache alongside its input.
+type submissionLog struct {
+	// Session identifies the account of the submissions, see InputCache.Session.
+	Session string `json:"session,omitempty"`
+
+	// CooldownUntil is when adventofcode.com accepts another answer to the puzzle.
+	CooldownUntil time.Time `json:"cooldown_until"`
+
+	// FetchedAt is when the input of the puzzle was first stored, see SolveTime.
+	FetchedAt time.Time `json:"fetched_at"`
+
+	// Parts holds the answers submitted to each part of the puzzle.
+	Parts map[Part]*guessLedger `json:"parts,omitempty"`
+}
+
+// guessLedger records the answers submitted to a part of a puzzle.
+type guessLedger struct {
+	Wrong []string `json:"wrong,omitempty"`
+
+	// SolvedAt is when the right answer was submitted.
+	SolvedAt time.Time `json:"solved_at"`
+
+	// Answer is the right answer, submitted or marked with MarkCorrect, see WithVerify.
+	Answer string `json:"answer,omitempty"`
+
+	// Low is the greatest answer known to be too low, and High the least answer known to be too high.
+	Low  string `json:"low,omitempty"`
+	High string `json:"high,omitempty"`
+}
+
+// checkGuess fails with ErrKnownWrongAnswer if answer to part is known to be wrong, because it was already
+// submitted or it is out of the bounds given by the answers that were too high or too low.
+func (l guessLedger) checkGuess(answer string) error {
+	if slices.Contains(l.Wrong, answer) {
+		return fmt.Errorf("%w: %s was already submitted", ErrKnownWrongAnswer, answer)
+	}
+
+	if cmp, ok := compareAnswers(answer, l.High); ok && cmp >= 0 {
+		return fmt.Errorf("%w: %s is not less than %s, which is too high", ErrKnownWrongAnswer, answer, l.High)
+	}
+
+	if cmp, ok := compareAnswers(answer, l.Low); ok && cmp <= 0 {
+		return fmt.Errorf("%w: %s is not greater than %s, which is too low", ErrKnownWrongAnswer, answer, l.Low)
+	}
+
+	return nil
+}
+
+// record adds answer to the ledger if its verdict shows it is wrong, or records it as the right answer, along
+// with when the part was solved.
+func (l *guessLedger) record(answer string, verdict Verdict) {
+	switch verdict {
+	case Correct:
+		if l.SolvedAt.IsZero() {
+			l.SolvedAt = time.Now().UTC()
+		}
+
+		l.Answer = answer
+
+		return
+	case Incorrect:
+	case TooHigh:
+		if cmp, ok := compareAnswers(answer, l.High); l.High == "" || ok && cmp < 0 {
+			l.High = answer
+		}
+	case TooLow:
+		if cmp, ok := compareAnswers(answer, l.Low); l.Low == "" || ok && cmp > 0 {
+			l.Low = answer
+		}
+	default:
+		return
+	}
+
+	if !slices.Contains(l.Wrong, answer) {
+		l.Wrong = append(l.Wrong, answer)
+	}
+}
+
+// compareAnswers compares the answers a and b as integers, like big.Int.Cmp. It reports false if either is not
+// an integer, such as an empty bound, as only integers can be out of bounds.
+func compareAnswers(a, b string) (int, bool) {
+	x, okA := new(big.Int).SetString(a, 10)
+	y, okB := new(big.Int).SetString(b, 10)
+
+	if !okA || !okB {
+		return 0, false
+	}
+
+	return x.Cmp(y), true
+}
+
+// checkSubmission fails with a CooldownError if the cooldown of the puzzle of the given year and day has not
+// elapsed, or with ErrKnownWrongAnswer if answer to part is known to be wrong.
+func (c InputCache) checkSubmission(year, day int, part Part, answer string) error {
+	log, err := c.loadSubmissions(year, day)
+	if err != nil {
+		return err
+	}
+
+	if remaining := time.Until(log.CooldownUntil); remaining > 0 {
+		return CooldownError{Remaining: remaining}
+	}
+
+	if ledger, ok := log.Parts[part]; ok {
+		return ledger.checkGuess(answer)
+	}
+
+	return nil
+}
+
+// recordSubmission records the cooldown asked for by submission, and answer to part if it is wrong.
+func (c InputCache) recordSubmission(year, day int, part Part, answer string, submission Submission) error {
+	log, err := c.loadSubmissions(year, day)
+	if err != nil {
+		return err
+	}
+
+	log.Session = c.Session
+
+	if submission.Wait > 0 {
+		log.CooldownUntil = time.Now().Add(submission.Wait).UTC()
+	}
+
+	log.ledger(part).record(answer, submission.Verdict)
+
+	return c.storeSubmissions(year, day, log)
+}
+
+// ledger returns the guessLedger of part, adding it if it is missing.
+func (l *submissionLog) ledger(part Part) *guessLedger {
+	if l.Parts == nil {
+		l.Parts = make(map[Part]*guessLedger)
+	}
+
+	if l.Parts[part] == nil {
+		l.Parts[part] = &guessLedger{}
+	}
+
+	return l.Parts[part]
+}
+
+// loadSubmissions returns the submissionLog of the puzzle of the given year and day, empty if there is none
+// or if it records the submissions of another account.
+func (c InputCache) loadSubmissions(year, day int) (submissionLog, error) {
+	var log submissionLog
+
+	content, err := os.ReadFile(c.submissionsPath(year, day))
+	if errors.Is(err, fs.ErrNotExist) {
+		return log, nil
+	}
+
+	if err != nil {
+		return log, err
+	}
+
+	if err := json.Unmarshal(content, &log); err != nil {
+		return log, err
+	}
+
+	if c.Session != "" && log.Session != "" && log.Session != c.Session {
+		return submissionLog{}, nil
+	}
+
+	return log, nil
+}
+
+// storeSubmissions saves the submissionLog of the puzzle of the given year and day.
+func (c InputCache) storeSubmissions(year, day int, log submissionLog) error {
+	path := c.submissionsPath(year, day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(log)
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.Write(path, content, 0o600)
+}
+
+// submissionsPath returns the path of the submissionLog of the puzzle of the given year and day,
+// e.g. <Dir>/2024/day07.submissions.json.
+func (c InputCache) submissionsPath(year, day int) string {
+	return strings.TrimSuffix(c.Path(year, day), ".txt") + ".submissions.json"
+}
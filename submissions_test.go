@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheckSubmission(t *testing.T) {
+	cache := InputCache{Dir: t.TempDir()}
+
+	for answer, verdict := range map[string]Verdict{"100": TooHigh, "150": TooHigh, "10": TooLow, "5": TooLow, "42": Incorrect, "abc": Incorrect} {
+		if err := cache.recordSubmission(2024, 7, 1, answer, Submission{Verdict: verdict}); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+	}
+
+	testCases := []struct {
+		answer string
+		part   Part
+		wrong  bool
+	}{
+		{answer: "42", part: 1, wrong: true},
+		{answer: "abc", part: 1, wrong: true},
+		{answer: "100", part: 1, wrong: true},
+		{answer: "120", part: 1, wrong: true},
+		{answer: "10", part: 1, wrong: true},
+		{answer: "7", part: 1, wrong: true},
+		{answer: "99", part: 1},
+		{answer: "11", part: 1},
+		{answer: "xyz", part: 1},
+		{answer: "42", part: 2},
+	}
+
+	for _, tc := range testCases {
+		err := cache.checkSubmission(2024, 7, tc.part, tc.answer)
+		if tc.wrong != errors.Is(err, ErrKnownWrongAnswer) {
+			t.Errorf("Expected %s to part %d to be wrong: %t, but got: %v", tc.answer, tc.part, tc.wrong, err)
+		}
+	}
+
+	ledger, err := cache.loadSubmissions(2024, 7)
+	if err != nil || ledger.Parts[1].High != "100" || ledger.Parts[1].Low != "10" {
+		t.Errorf("Expected the bounds 10 and 100, but got %+v (err: %v)", ledger.Parts[1], err)
+	}
+}
+
+func TestCheckSubmissionCooldown(t *testing.T) {
+	cache := InputCache{Dir: t.TempDir(), Session: SessionID("other")}
+	if err := cache.recordSubmission(2024, 7, 1, "42", Submission{Verdict: Incorrect, Wait: time.Hour}); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	var cooldownErr CooldownError
+	if err := cache.checkSubmission(2024, 7, 2, "1"); !errors.As(err, &cooldownErr) || cooldownErr.Remaining <= 59*time.Minute {
+		t.Errorf("Expected a CooldownError with about 1h remaining, but got: %v", err)
+	}
+
+	// The submissions of another account are ignored.
+	cache.Session = SessionID("secret")
+
+	if err := cache.checkSubmission(2024, 7, 1, "42"); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+}
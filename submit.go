@@ -0,0 +1,318 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Submit submits the answer of the given part of the puzzle of the given year and day to adventofcode.com,
+// authenticated by the session cookie resolved by ResolveSession, and returns the Submission parsed from the
+// response, with its Verdict. A submission is never retried, so an answer is not submitted twice.
+// When adventofcode.com asks to wait before the next answer, the deadline is recorded in the DefaultInputCache,
+// and answers submitted before it fail with a CooldownError, without reaching adventofcode.com. Wrong answers
+// are recorded too, and submitting one of them again, or an answer out of the bounds given by the answers that
+// were too high or too low, fails with ErrKnownWrongAnswer.
+// A part other than 1 or 2 fails with an InvalidPartError, a failed request with ErrSubmitFailed, and an
+// unrecognized response with ErrUnknownVerdict.
+//
+// The request options, such as WithHTTPClient, WithSession, WithCacheDir or WithClient, configure how the
+// answer is submitted; other options are ignored.
+//
+// Example:
+//
+//	submission, err := Submit(ctx, 2024, 7, 1, "3749", WithHTTPClient(client))
+//	if err == nil && submission.Verdict == TooHigh {
+//	    log.Printf("%s is too high", answer)
+//	}
+func Submit(ctx context.Context, year, day int, part Part, answer string, options ...RunOption) (Submission, error) {
+	var opts runOptions
+	if err := applyOptions(&opts, options...); err != nil {
+		return Submission{}, err
+	}
+
+	return opts.sendAnswer(ctx, year, day, part, answer)
+}
+
+// SubmitMode sets whether answers submitted by WithAutoSubmit are confirmed first.
+type SubmitMode int
+
+const (
+	// Confirm prompts on the console before each answer is submitted, e.g.
+	// "Submit 982347 for 2024 day 7 part 2? [y/N]", and only submits it on "y" or "yes".
+	Confirm SubmitMode = iota + 1
+
+	// NoConfirm submits each answer without prompting.
+	NoConfirm
+
+	// DryRun writes what would be submitted for each answer, with the recorded cooldown and wrong answers of the
+	// part, but submits nothing and makes no request, e.g. to check WithAutoSubmit before enabling it.
+	DryRun
+)
+
+// WithAutoSubmit creates a RunOption that submits each answer to adventofcode.com after it is written, like
+// Submit, and writes the verdict of the response, see Submission.String, to the stdout of the console manager,
+// or os.Stdout for other OutputWriters. With Confirm, the answer is only submitted once confirmed on the stdin
+// of the console manager, and never when the stdin is piped. With DryRun, nothing is submitted: the request
+// that would be sent is written instead, along with the recorded cooldown and wrong answers.
+// Once part 1 is accepted, the puzzle page is downloaded again and the newly revealed description of part 2 is
+// stored with the examples in the input cache, see InputCache.DescriptionPath.
+//
+// The answer is submitted as returned by the part rather than rendered by WithFormatter. The year and day are
+// the ones set with WithYear and WithDay, given to an input option, or inferred from the directory names,
+// failing with ErrUnknownPuzzle when unknown. Answers of the sample input are never submitted, and with WithWatch,
+// an answer is only submitted, or confirmed, the first time a part returns it.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 7), WithAutoSubmit(Confirm))
+func WithAutoSubmit(mode SubmitMode) RunOption {
+	return func(options *runOptions) error {
+		if mode != Confirm && mode != NoConfirm && mode != DryRun {
+			return fmt.Errorf("%w: %d", ErrInvalidSubmitMode, mode)
+		}
+
+		options.submitMode = mode
+
+		return nil
+	}
+}
+
+// WithSubmit creates a RunOption that submits each answer without confirmation, like WithAutoSubmit(NoConfirm).
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 7), WithSubmit())
+func WithSubmit() RunOption {
+	return WithAutoSubmit(NoConfirm)
+}
+
+// submitAnswer submits the answer of part, as configured by WithAutoSubmit, and writes the verdict of the response.
+func (o *runOptions) submitAnswer(ctx context.Context, part Part, answer string) error {
+	year, day, err := o.puzzle(0, 0)
+	if err != nil {
+		return err
+	}
+
+	if o.submitMode == DryRun {
+		return o.writeDryRun(year, day, part, answer)
+	}
+
+	// While watching, the part runs again on every change of the input, so each answer is only offered once.
+	if o.watch {
+		key := submittedAnswer{part: part, answer: answer}
+		if o.submitted[key] {
+			return nil
+		}
+
+		if o.submitted == nil {
+			o.submitted = make(map[submittedAnswer]bool)
+		}
+
+		o.submitted[key] = true
+	}
+
+	if o.submitMode == Confirm {
+		confirmed, err := o.confirm(fmt.Sprintf("Submit %s for %d day %d part %d? [y/N] ", answer, year, day, part))
+		if err != nil || !confirmed {
+			return err
+		}
+	}
+
+	submission, err := o.sendAnswer(ctx, year, day, part, answer)
+	if err != nil {
+		return err
+	}
+
+	consoleMu.Lock()
+	_, err = fmt.Fprintf(o.stdout(), "Part %d: %s%s\n", part, submission, o.solveTimeNote(year, day, part, submission))
+	consoleMu.Unlock()
+
+	if err != nil {
+		return IOWriteError{Err: err}
+	}
+
+	if submission.Verdict == Correct {
+		o.notify(ctx, fmt.Sprintf("Part %d: correct!", part))
+	}
+
+	if part == 1 && submission.Verdict == Correct {
+		o.writePartTwo(ctx, year, day)
+	}
+
+	return nil
+}
+
+// submittedAnswer is an answer to a part already submitted, or refused at the confirmation, during a watch.
+type submittedAnswer struct {
+	part   Part
+	answer string
+}
+
+// writeDryRun writes the request that would submit the answer of part, and the cooldown and wrong answers recorded
+// for it, without sending anything, e.g.:
+//
+//	Dry run, not submitted: POST https://adventofcode.com/2024/day/7/answer level=2 answer=3749
+//	  cooldown: 42s left
+//	  wrong answers: 100, 5000 (too low: 100, too high: 5000)
+//	  rejected locally: answer is known to be wrong: 5000 was already submitted
+func (o *runOptions) writeDryRun(year, day int, part Part, answer string) error {
+	cache, err := o.inputCache()
+	if err != nil {
+		return err
+	}
+
+	log, err := cache.loadSubmissions(year, day)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{"level": {strconv.Itoa(int(part))}, "answer": {answer}}
+	report := fmt.Sprintf("Dry run, not submitted: POST %s/%d/day/%d/answer %s\n", o.aocProvider().baseURL, year, day, form.Encode())
+
+	cooldown := "none"
+	if remaining := time.Until(log.CooldownUntil); remaining > 0 {
+		cooldown = remaining.Round(time.Second).String() + " left"
+	}
+
+	report += "  cooldown: " + cooldown + "\n"
+
+	wrong := "none"
+	if ledger, ok := log.Parts[part]; ok && len(ledger.Wrong) > 0 {
+		wrong = strings.Join(ledger.Wrong, ", ")
+
+		var bounds []string
+		if ledger.Low != "" {
+			bounds = append(bounds, "too low: "+ledger.Low)
+		}
+
+		if ledger.High != "" {
+			bounds = append(bounds, "too high: "+ledger.High)
+		}
+
+		if len(bounds) > 0 {
+			wrong += " (" + strings.Join(bounds, ", ") + ")"
+		}
+	}
+
+	report += "  wrong answers: " + wrong + "\n"
+
+	if err := cache.checkSubmission(year, day, part, answer); err != nil {
+		report += "  rejected locally: " + err.Error() + "\n"
+	}
+
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if _, err := fmt.Fprint(o.stdout(), report); err != nil {
+		return IOWriteError{Err: err}
+	}
+
+	return nil
+}
+
+// solveTimeNote returns a note with the time taken to solve part, when submission is its right answer and the
+// time is known, e.g. ", solved in 12m3s (1h2m0s since unlock)".
+func (o *runOptions) solveTimeNote(year, day int, part Part, submission Submission) string {
+	if submission.Verdict != Correct {
+		return ""
+	}
+
+	cache, err := o.inputCache()
+	if err != nil {
+		return ""
+	}
+
+	solveTime, ok, err := cache.solveTime(year, day, part)
+	if err != nil || !ok {
+		return ""
+	}
+
+	return fmt.Sprintf(", solved in %s (%s since unlock)", solveTime.Duration().Round(time.Second), solveTime.SinceUnlock().Round(time.Second))
+}
+
+// confirm writes the question to the stdout of the console manager and reports whether it is answered with
+// "y" or "yes" on its stdin. A piped stdin holds no answer, so the question is not asked and not confirmed.
+func (o *runOptions) confirm(question string) (bool, error) {
+	stdin := o.stdin()
+	if isPiped(stdin) {
+		return false, nil
+	}
+
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if _, err := fmt.Fprint(o.stdout(), question); err != nil {
+		return false, IOWriteError{Err: err}
+	}
+
+	var reply string
+
+	// An empty reply is not an error, but a refusal.
+	_, _ = fmt.Fscanln(stdin, &reply)
+
+	reply = strings.ToLower(strings.TrimSpace(reply))
+
+	return reply == "y" || reply == "yes", nil
+}
+
+// sendAnswer submits the answer of part to the puzzle of the given year and day, unless the cooldown recorded
+// in the input cache has not elapsed or the answer is known to be wrong, and records the cooldown asked for
+// by adventofcode.com and the wrong answers.
+func (o *runOptions) sendAnswer(ctx context.Context, year, day int, part Part, answer string) (Submission, error) {
+	cache, err := o.inputCache()
+	if err != nil {
+		return Submission{}, err
+	}
+
+	if err := cache.checkSubmission(year, day, part, answer); err != nil {
+		return Submission{}, err
+	}
+
+	submission, err := o.aocProvider().submit(ctx, year, day, part, answer)
+	if err != nil {
+		return Submission{}, err
+	}
+
+	return submission, cache.recordSubmission(year, day, part, answer, submission)
+}
+
+// stdout returns the stdout of the console manager, or os.Stdout for other OutputWriters.
+func (o *runOptions) stdout() io.Writer {
+	switch writer := o.writer.(type) {
+	case DefaultConsoleManager:
+		return writer.Env.Stdout
+	case *DefaultConsoleManager:
+		return writer.Env.Stdout
+	default:
+		return os.Stdout
+	}
+}
+
+// submit posts the answer of part to adventofcode.com and returns the Submission parsed from the response.
+func (p aocProvider) submit(ctx context.Context, year, day int, part Part, answer string) (Submission, error) {
+	if _, err := NewPartOf(int(part), 2); err != nil {
+		return Submission{}, err
+	}
+
+	client, source, err := p.client()
+	if err != nil {
+		return Submission{}, err
+	}
+
+	message, err := client.Submit(ctx, year, day, int(part), answer)
+	if err != nil {
+		return Submission{}, apiError(ErrSubmitFailed, err, source)
+	}
+
+	return classify(message)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+// answerPage is a response of adventofcode.com to a submitted answer.
+const answerPage = `<html><body><main>
+<article><p>That's the right answer!  You are <em>one gold star</em> closer to saving Christmas. [<a href="/2024">Return to Advent Calendar</a>]</p></article>
+</main></body></html>`
+
+func newAnswerServer(t *testing.T, submissions *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/2024/day/7" {
+			_, _ = w.Write([]byte(puzzlePage))
+
+			return
+		}
+
+		*submissions++
+
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/2024/day/7/answer" ||
+			r.PostFormValue("level") != "2" || r.PostFormValue("answer") != "3749" {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(answerPage))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSubmit(t *testing.T) {
+	submissions := 0
+	server := newAnswerServer(t, &submissions)
+	provider := aocProvider{baseURL: server.URL, session: "secret"}
+
+	submission, err := provider.submit(context.Background(), 2024, 7, 2, "3749")
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	expected := "That's the right answer! You are one gold star closer to saving Christmas. [Return to Advent Calendar]"
+	if submission.Verdict != Correct || submission.Message != expected {
+		t.Errorf("Expected a correct verdict with message %q, but got %+v", expected, submission)
+	}
+
+	// A failed submission is not retried, so the answer is not submitted twice.
+	provider.retry = aocapi.RetryPolicy{Retries: 3}
+
+	if _, err := provider.submit(context.Background(), 2024, 7, 2, "42"); !errors.Is(err, ErrSubmitFailed) {
+		t.Errorf("Expected ErrSubmitFailed, but got: %v", err)
+	}
+
+	if submissions != 2 {
+		t.Errorf("Expected 2 submissions, but got %d", submissions)
+	}
+}
+
+func TestSubmitInvalidPart(t *testing.T) {
+	t.Setenv("GOAOC_CACHE_DIR", t.TempDir())
+
+	var partErr InvalidPartError
+
+	if _, err := Submit(context.Background(), 2024, 7, 3, "42"); !errors.As(err, &partErr) {
+		t.Errorf("Expected an InvalidPartError, but got: %v", err)
+	}
+}
+
+func TestWithSubmit(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	submissions := 0
+	server := newAnswerServer(t, &submissions)
+	stdout := new(bytes.Buffer)
+	challenges := []ChallengeE[int]{
+		func(string) (int, error) { return 0, nil },
+		func(string) (int, error) { return 3749, nil },
+	}
+
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+	manager := DefaultConsoleManager{Env: mockEnv([]string{}, "", stdout)}
+
+	_, err := runWith(context.Background(), &opts, challenges,
+		WithManager(manager), WithPart(2), WithInputString("input"), WithYear(2024), WithDay(7), WithSubmit())
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if expected := "Day 7: Bridge & Repair ★☆\nThe challenge result is 3749\nPart 2: correct, solved in "; !strings.HasPrefix(stdout.String(), expected) {
+		t.Errorf("Expected the banner, the answer and the verdict in the output %q, but got %q", expected, stdout.String())
+	}
+
+	opts = runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+
+	_, err = runWith(context.Background(), &opts, challenges, WithManager(manager), WithPart(2), WithInputString("input"), WithSubmit())
+	if !errors.Is(err, ErrUnknownPuzzle) {
+		t.Errorf("Expected ErrUnknownPuzzle, but got: %v", err)
+	}
+
+	if submissions != 1 {
+		t.Errorf("Expected 1 submission, but got %d", submissions)
+	}
+}
+
+func TestSubmitCooldown(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	submissions := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		submissions++
+
+		_, _ = w.Write([]byte("<article><p>That's not the right answer; your answer is too high. Please wait one minute before trying again.</p></article>"))
+	}))
+	t.Cleanup(server.Close)
+
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+
+	submission, err := opts.sendAnswer(context.Background(), 2024, 7, 1, "42")
+	if err != nil || submission.Verdict != TooHigh || submission.Wait != time.Minute {
+		t.Fatalf("Expected a too high verdict with a wait of 1m, but got %+v (err: %v)", submission, err)
+	}
+
+	var cooldownErr CooldownError
+
+	_, err = opts.sendAnswer(context.Background(), 2024, 7, 2, "41")
+	if !errors.As(err, &cooldownErr) || cooldownErr.Remaining <= 0 || cooldownErr.Remaining > time.Minute {
+		t.Errorf("Expected a CooldownError with less than 1m remaining, but got: %v", err)
+	}
+
+	// The cooldown is per puzzle.
+	if _, err := opts.sendAnswer(context.Background(), 2024, 8, 1, "42"); err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+
+	if submissions != 2 {
+		t.Errorf("Expected 2 submissions, but got %d", submissions)
+	}
+}
+
+func TestWithAutoSubmitConfirm(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	submissions := 0
+	server := newAnswerServer(t, &submissions)
+	challenges := []ChallengeE[int]{
+		func(string) (int, error) { return 0, nil },
+		func(string) (int, error) { return 3749, nil },
+	}
+
+	testCases := []struct {
+		reply     string
+		submitted bool
+	}{
+		{reply: "y\n", submitted: true},
+		{reply: "YES\n", submitted: true},
+		{reply: "n\n"},
+		{reply: "\n"},
+		{reply: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.reply, func(t *testing.T) {
+			submissions = 0
+			stdout := new(bytes.Buffer)
+			manager := DefaultConsoleManager{Env: mockEnv([]string{}, tc.reply, stdout)}
+			opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+
+			_, err := runWith(context.Background(), &opts, challenges,
+				WithManager(manager), WithPart(2), WithInputString("input"), WithYear(2024), WithDay(7), WithAutoSubmit(Confirm))
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+
+			if !bytes.Contains(stdout.Bytes(), []byte("Submit 3749 for 2024 day 7 part 2? [y/N] ")) {
+				t.Errorf("Expected the confirmation prompt, but got %q", stdout.String())
+			}
+
+			if submitted := submissions == 1; submitted != tc.submitted {
+				t.Errorf("Expected the answer to be submitted: %t, but got %d submissions", tc.submitted, submissions)
+			}
+		})
+	}
+}
+
+func TestWithAutoSubmitInvalidMode(t *testing.T) {
+	var opts runOptions
+
+	if err := WithAutoSubmit(0)(&opts); !errors.Is(err, ErrInvalidSubmitMode) {
+		t.Errorf("Expected ErrInvalidSubmitMode, but got: %v", err)
+	}
+}
+
+func TestWithAutoSubmitDryRun(t *testing.T) {
+	t.Setenv("GOAOC_SESSION", "secret")
+
+	submissions := 0
+	server := newAnswerServer(t, &submissions)
+	stdout := new(bytes.Buffer)
+	challenges := []ChallengeE[int]{
+		func(string) (int, error) { return 0, nil },
+		func(string) (int, error) { return 3749, nil },
+	}
+
+	opts := runOptions{baseURL: server.URL, cacheDir: t.TempDir()}
+
+	cache, err := opts.inputCache()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := cache.recordSubmission(2024, 7, 2, "3000", Submission{Verdict: TooHigh}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	manager := DefaultConsoleManager{Env: mockEnv([]string{}, "", stdout)}
+
+	_, err = runWith(context.Background(), &opts, challenges,
+		WithManager(manager), WithPart(2), WithInputString("input"), WithYear(2024), WithDay(7), WithAutoSubmit(DryRun))
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	for _, expected := range []string{
+		"Dry run, not submitted: POST " + server.URL + "/2024/day/7/answer answer=3749&level=2\n",
+		"  cooldown: none\n",
+		"  wrong answers: 3000 (too high: 3000)\n",
+		"  rejected locally: answer is known to be wrong: 3749 is not less than 3000, which is too high\n",
+	} {
+		if !strings.Contains(stdout.String(), expected) {
+			t.Errorf("Expected %q in the output, but got %q", expected, stdout.String())
+		}
+	}
+
+	if submissions != 0 {
+		t.Errorf("Expected no submission, but got %d", submissions)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hvpaiva/goaoc/aocapi"
+)
+
+// WaitForUnlock blocks until the puzzle of the given year and day unlocks, at midnight EST, or ctx is done,
+// returning its cause. It returns immediately for a puzzle already unlocked.
+//
+// Example:
+//
+//	if err := WaitForUnlock(ctx, 2024, 7); err != nil {
+//	    return err
+//	}
+func WaitForUnlock(ctx context.Context, year, day int) error {
+	return sleepUntil(ctx, aocapi.UnlockTime(year, day))
+}
+
+// WithWaitForUnlock creates a RunOption that waits until the puzzle unlocks, at midnight EST, before the run,
+// so the input is downloaded and the parts are run as soon as possible, e.g. for a leaderboard attempt started
+// a minute early. The time left is written to the stdout of the console manager while waiting. The year and
+// day are the ones set with WithYear and WithDay, given to an input option, or inferred from the directory
+// names, failing with ErrUnknownPuzzle when unknown. The unlock time is read from the system clock, which
+// should be synchronized: when it is ahead, the input answered with 404 Not Found is polled during the minute
+// following the unlock, see aocapi.HTTPClient.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 7), WithWaitForUnlock(), WithSubmit())
+func WithWaitForUnlock() RunOption {
+	return func(options *runOptions) error {
+		options.waitUnlock = true
+
+		return nil
+	}
+}
+
+// waitForUnlock waits until the puzzle of the run unlocks, as configured by WithWaitForUnlock, writing the
+// time left to the console.
+func (o *runOptions) waitForUnlock(ctx context.Context) error {
+	year, day, err := o.puzzle(0, 0)
+	if err != nil {
+		return err
+	}
+
+	unlock := aocapi.UnlockTime(year, day)
+
+	remaining := time.Until(unlock)
+	if remaining <= 0 {
+		return nil
+	}
+
+	if o.consoleOutput() {
+		consoleMu.Lock()
+		_, err := fmt.Fprintf(o.stdout(), "Waiting %s for %d day %d to unlock...\n", remaining.Round(time.Second), year, day)
+		consoleMu.Unlock()
+
+		if err != nil {
+			return IOWriteError{Err: err}
+		}
+	}
+
+	return sleepUntil(ctx, unlock)
+}
+
+// sleepUntil blocks until the deadline has passed or ctx is done, returning its cause.
+func sleepUntil(ctx context.Context, deadline time.Time) error {
+	delay := time.Until(deadline)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForUnlock(t *testing.T) {
+	if err := WaitForUnlock(context.Background(), 2015, 1); err != nil {
+		t.Errorf("Expected no wait for an unlocked puzzle, but got: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := WaitForUnlock(ctx, time.Now().Year()+1, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the deadline to be exceeded, but got: %v", err)
+	}
+}
+
+func TestWithWaitForUnlock(t *testing.T) {
+	stdout := new(bytes.Buffer)
+	manager := DefaultConsoleManager{Env: mockEnv([]string{}, "", stdout)}
+	challenges := []ChallengeE[int]{
+		func(string) (int, error) { return 1, nil },
+		func(string) (int, error) { return 2, nil },
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	opts := runOptions{cacheDir: t.TempDir(), offline: true}
+
+	_, err := runWith(ctx, &opts, challenges,
+		WithManager(manager), WithPart(1), WithInputString("input"), WithYear(time.Now().Year()+1), WithDay(1), WithWaitForUnlock())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the deadline to be exceeded while waiting, but got: %v", err)
+	}
+
+	if !strings.HasPrefix(stdout.String(), "Waiting ") || strings.Contains(stdout.String(), "The challenge result") {
+		t.Errorf("Expected only the time left in the output, but got %q", stdout.String())
+	}
+
+	opts = runOptions{cacheDir: t.TempDir(), offline: true}
+
+	_, err = runWith(context.Background(), &opts, challenges, WithManager(manager), WithPart(1), WithInputString("input"), WithWaitForUnlock())
+	if !errors.Is(err, ErrUnknownPuzzle) {
+		t.Errorf("Expected ErrUnknownPuzzle, but got: %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the outcome of an answer submitted to adventofcode.com, see Submit.
+type Verdict int
+
+const (
+	// Correct is the verdict of a right answer, earning a star.
+	Correct Verdict = iota + 1
+
+	// Incorrect is the verdict of a wrong answer, without a hint of whether it is too high or too low.
+	Incorrect
+
+	// TooHigh is the verdict of a wrong answer greater than the right one.
+	TooHigh
+
+	// TooLow is the verdict of a wrong answer less than the right one.
+	TooLow
+
+	// RateLimited is the verdict of an answer submitted too soon after a wrong one. It was not checked.
+	RateLimited
+
+	// AlreadyCompleted is the verdict of an answer to a part already solved, or not unlocked yet. It was not checked.
+	AlreadyCompleted
+)
+
+// String returns a description of the verdict, such as "too high".
+func (v Verdict) String() string {
+	switch v {
+	case Correct:
+		return "correct"
+	case Incorrect:
+		return "incorrect"
+	case TooHigh:
+		return "too high"
+	case TooLow:
+		return "too low"
+	case RateLimited:
+		return "rate limited"
+	case AlreadyCompleted:
+		return "already completed"
+	default:
+		return "Verdict(" + strconv.Itoa(int(v)) + ")"
+	}
+}
+
+// Submission is the response of adventofcode.com to a submitted answer.
+type Submission struct {
+	Verdict Verdict
+
+	// Wait is how long to wait before submitting another answer, after a wrong or rate limited one.
+	Wait time.Duration
+
+	// Message is the text of the response, such as "That's the right answer! ...".
+	Message string
+}
+
+// String returns the verdict of the submission and how long to wait, if needed, e.g. "too high, wait 1m0s".
+func (s Submission) String() string {
+	if s.Wait > 0 {
+		return fmt.Sprintf("%s, wait %s", s.Verdict, s.Wait)
+	}
+
+	return s.Verdict.String()
+}
+
+var (
+	// leftToWait matches the time left to wait in the message of a rate limited answer, e.g. "You have 4m 32s left to wait".
+	leftToWait = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+
+	// waitBeforeRetry matches the time to wait in the message of a wrong answer, e.g. "Please wait 5 minutes".
+	waitBeforeRetry = regexp.MustCompile(`(?i)wait (one|\d+) minutes?`)
+)
+
+// classify returns the Submission of the message of a response to an answer, failing with ErrUnknownVerdict
+// when the message is not recognized.
+func classify(message string) (Submission, error) {
+	submission := Submission{Message: message}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		submission.Verdict = Correct
+	case strings.Contains(message, "You gave an answer too recently"):
+		submission.Verdict = RateLimited
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		submission.Verdict = AlreadyCompleted
+	case strings.Contains(message, "your answer is too high"):
+		submission.Verdict = TooHigh
+	case strings.Contains(message, "your answer is too low"):
+		submission.Verdict = TooLow
+	case strings.Contains(message, "That's not the right answer"):
+		submission.Verdict = Incorrect
+	default:
+		return Submission{}, fmt.Errorf("%w: %q", ErrUnknownVerdict, message)
+	}
+
+	if submission.Verdict != Correct && submission.Verdict != AlreadyCompleted {
+		submission.Wait = waitOf(message)
+	}
+
+	return submission, nil
+}
+
+// waitOf returns the time to wait before submitting another answer given in message, or zero if there is none.
+func waitOf(message string) time.Duration {
+	if match := leftToWait.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+
+		return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	}
+
+	if match := waitBeforeRetry.FindStringSubmatch(message); match != nil {
+		minutes, err := strconv.Atoi(match[1])
+		if err != nil {
+			minutes = 1 // "one minute"
+		}
+
+		return time.Duration(minutes) * time.Minute
+	}
+
+	return 0
+}
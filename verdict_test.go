@@ -0,0 +1,90 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		name    string
+		message string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{
+			name:    "Correct",
+			message: "That's the right answer! You are one gold star closer to saving Christmas.",
+			verdict: Correct,
+		},
+		{
+			name:    "Incorrect",
+			message: "That's not the right answer. If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.",
+			verdict: Incorrect,
+			wait:    time.Minute,
+		},
+		{
+			name:    "TooHigh",
+			message: "That's not the right answer; your answer is too high. Please wait one minute before trying again.",
+			verdict: TooHigh,
+			wait:    time.Minute,
+		},
+		{
+			name:    "TooLow",
+			message: "That's not the right answer; your answer is too low. Because you have guessed incorrectly 5 times on this puzzle, please wait 5 minutes before trying again.",
+			verdict: TooLow,
+			wait:    5 * time.Minute,
+		},
+		{
+			name:    "RateLimited",
+			message: "You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 4m 32s left to wait.",
+			verdict: RateLimited,
+			wait:    4*time.Minute + 32*time.Second,
+		},
+		{
+			name:    "RateLimitedSeconds",
+			message: "You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 45s left to wait.",
+			verdict: RateLimited,
+			wait:    45 * time.Second,
+		},
+		{
+			name:    "AlreadyCompleted",
+			message: "You don't seem to be solving the right level. Did you already complete it? [Return to Day 7]",
+			verdict: AlreadyCompleted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			submission, err := classify(tc.message)
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+
+			if submission.Verdict != tc.verdict || submission.Wait != tc.wait || submission.Message != tc.message {
+				t.Errorf("Expected verdict %s with wait %s, but got %+v", tc.verdict, tc.wait, submission)
+			}
+		})
+	}
+}
+
+func TestClassifyUnknown(t *testing.T) {
+	if _, err := classify("Something unexpected"); !errors.Is(err, ErrUnknownVerdict) {
+		t.Errorf("Expected ErrUnknownVerdict, but got: %v", err)
+	}
+}
+
+func TestSubmissionString(t *testing.T) {
+	if s := (Submission{Verdict: TooHigh, Wait: time.Minute}).String(); s != "too high, wait 1m0s" {
+		t.Errorf("Expected %q, but got %q", "too high, wait 1m0s", s)
+	}
+
+	if s := (Submission{Verdict: Correct}).String(); s != "correct" {
+		t.Errorf("Expected %q, but got %q", "correct", s)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"fmt"
+)
+
+// WithVerify creates a RunOption that enables the verify mode, also enabled by the -verify flag: each answer is
+// compared with the verified answer of its part, the right answer submitted with Submit or WithAutoSubmit, or
+// marked with MarkCorrect. The outcome is written after the answer to the stdout of the console manager, or
+// os.Stdout for other OutputWriters, and an answer differing from the verified one fails with a RegressionError,
+// so refactors can be checked against the real inputs. Answers of the sample input are not verified.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithInputDownload(2024, 7), WithVerify())
+func WithVerify() RunOption {
+	return func(options *runOptions) error {
+		options.verify = true
+
+		return nil
+	}
+}
+
+// MarkCorrect records answer as the right answer of the given part of the puzzle of the given year and day in
+// the DefaultInputCache, e.g. for a puzzle solved before using goaoc, so it is checked by WithVerify.
+//
+// Example:
+//
+//	err := MarkCorrect(2024, 7, 1, "3749")
+func MarkCorrect(year, day int, part Part, answer string) error {
+	cache, err := DefaultInputCache()
+	if err != nil {
+		return err
+	}
+
+	return cache.MarkCorrect(year, day, part, answer)
+}
+
+// MarkCorrect records answer as the right answer of the given part of the puzzle of the given year and day,
+// see the MarkCorrect function. Unlike a submitted answer, it does not record a solve time.
+func (c InputCache) MarkCorrect(year, day int, part Part, answer string) error {
+	log, err := c.loadSubmissions(year, day)
+	if err != nil {
+		return err
+	}
+
+	log.Session = c.Session
+	log.ledger(part).Answer = answer
+
+	return c.storeSubmissions(year, day, log)
+}
+
+// VerifiedAnswer returns the right answer of the given part of the puzzle of the given year and day, and
+// reports false if it is not known.
+func (c InputCache) VerifiedAnswer(year, day int, part Part) (string, bool, error) {
+	log, err := c.loadSubmissions(year, day)
+	if err != nil {
+		return "", false, err
+	}
+
+	if ledger, ok := log.Parts[part]; ok && ledger.Answer != "" {
+		return ledger.Answer, true, nil
+	}
+
+	return "", false, nil
+}
+
+// verifyAnswer compares answer with the verified answer of part, writing the outcome, and fails with a
+// RegressionError if they differ.
+func (o *runOptions) verifyAnswer(part Part, answer string) error {
+	year, day, err := o.puzzle(0, 0)
+	if err != nil {
+		return err
+	}
+
+	cache, err := o.inputCache()
+	if err != nil {
+		return err
+	}
+
+	verified, ok, err := cache.VerifiedAnswer(year, day, part)
+	if err != nil {
+		return err
+	}
+
+	outcome := "verified"
+
+	switch {
+	case !ok:
+		outcome = "no verified answer yet"
+	case verified != answer:
+		outcome = "REGRESSION, the verified answer is " + verified
+	}
+
+	consoleMu.Lock()
+	defer consoleMu.Unlock()
+
+	if _, err := fmt.Fprintf(o.stdout(), "Part %d: %s\n", part, outcome); err != nil {
+		return IOWriteError{Err: err}
+	}
+
+	if ok && verified != answer {
+		return RegressionError{Part: part, Answer: answer, Verified: verified}
+	}
+
+	return nil
+}
+
+// verifyInConsole reports whether the -verify flag is set, when reader is a DefaultConsoleManager.
+func verifyInConsole(reader InputReader) (bool, error) {
+	switch console := reader.(type) {
+	case DefaultConsoleManager:
+		return getVerifyInFlag(console.Env)
+	case *DefaultConsoleManager:
+		return getVerifyInFlag(console.Env)
+	default:
+		return false, nil
+	}
+}
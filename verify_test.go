@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestVerifiedAnswer(t *testing.T) {
+	cache := InputCache{Dir: t.TempDir()}
+
+	if err := cache.recordSubmission(2024, 7, 1, "3749", Submission{Verdict: Correct}); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	if err := cache.MarkCorrect(2024, 7, 2, "11387"); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	for part, expected := range map[Part]string{1: "3749", 2: "11387"} {
+		if answer, ok, err := cache.VerifiedAnswer(2024, 7, part); err != nil || !ok || answer != expected {
+			t.Errorf("Expected the verified answer %s of part %d, but got %s (ok: %t, err: %v)", expected, part, answer, ok, err)
+		}
+	}
+
+	// A marked answer has no solve time.
+	if times, err := cache.SolveTimes(2024, 7); err != nil || len(times) != 1 || times[0].Part != 1 {
+		t.Errorf("Expected only part 1 to have a solve time, but got %+v (err: %v)", times, err)
+	}
+
+	if _, ok, err := cache.VerifiedAnswer(2024, 8, 1); err != nil || ok {
+		t.Errorf("Expected no verified answer, but got ok: %t (err: %v)", ok, err)
+	}
+}
+
+func TestVerifyMode(t *testing.T) {
+	dir := t.TempDir()
+	cache := InputCache{Dir: dir}
+
+	if err := cache.MarkCorrect(2024, 7, 1, "3749"); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+
+	writeFile(t, cache.ExamplePath(2024, 7, 1), "sample")
+
+	testCases := []struct {
+		name     string
+		part     int
+		answer   int
+		args     []string
+		options  []RunOption
+		expected string
+		err      bool
+	}{
+		{name: "Verified", part: 1, answer: 3749, options: []RunOption{WithVerify()}, expected: "Part 1: verified\n"},
+		{name: "Flag", part: 1, answer: 3749, args: []string{"-verify"}, expected: "Part 1: verified\n"},
+		{name: "Regression", part: 1, answer: 42, options: []RunOption{WithVerify()}, expected: "Part 1: REGRESSION, the verified answer is 3749\n", err: true},
+		{name: "Unknown", part: 2, answer: 42, options: []RunOption{WithVerify()}, expected: "Part 2: no verified answer yet\n"},
+		{name: "Sample", part: 1, answer: 42, options: []RunOption{WithVerify(), WithSample()}},
+		{name: "Disabled", part: 1, answer: 42},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stdout := new(bytes.Buffer)
+			challenges := []ChallengeE[int]{
+				func(string) (int, error) { return tc.answer, nil },
+				func(string) (int, error) { return tc.answer, nil },
+			}
+
+			opts := runOptions{cacheDir: dir}
+			options := append([]RunOption{
+				WithManager(DefaultConsoleManager{Env: mockEnv(tc.args, "", stdout)}),
+				WithPart(tc.part), WithInputString("input"), WithYear(2024), WithDay(7),
+			}, tc.options...)
+
+			_, err := runWith(context.Background(), &opts, challenges, options...)
+
+			var regressionErr RegressionError
+			if tc.err != errors.As(err, &regressionErr) {
+				t.Errorf("Expected a RegressionError: %t, but got: %v", tc.err, err)
+			}
+
+			if !tc.err && err != nil {
+				t.Errorf("Expected no error, but got: %v", err)
+			}
+
+			if expected := "The challenge result is " + strconv.Itoa(tc.answer) + "\n" + tc.expected; stdout.String() != expected {
+				t.Errorf("Expected %q, but got %q", expected, stdout.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+// watchInterval is how often the watched input file is checked for changes. The file is polled rather than
+// watched with fsnotify, which would be the only third-party dependency of goaoc for a single small file: polling
+// works the same on every OS, and keeps following the file when an editor saves it by replacing it with a new
+// file, which ends the inotify watch of the replaced one.
+var watchInterval = 250 * time.Millisecond
+
+// WithWatch creates a RunOption that enables the watch mode: after running the selected part, the input file,
+// set with WithInputFile or found by WithSample, is watched, and the part is run again whenever the file
+// changes, e.g. while pasting variations of an example. The part is resolved only once. The file is polled
+// every 250ms, comparing its modification time and size. With WithAutoSubmit, each answer of a part is only
+// submitted the first time it is returned, so saving the file again does not submit the same answer twice.
+//
+// Failed runs do not stop the watch: their errors are passed to the WithOnError handlers, or written to stderr
+// when there is none. The watch stops when the context of the run is done, returning the results of the last
+// run and the cause of the context; the runs of Solve and Run are only stopped by interrupting the program.
+// Without an input file to watch, the run fails with ErrNothingToWatch.
+//
+// Example:
+//
+//	err := Solve(part1Func, part2Func, WithSample(), WithWatch())
+func WithWatch() RunOption {
+	return func(options *runOptions) error {
+		options.watch = true
+
+		return nil
+	}
+}
+
+// watch runs the challenges with opts, then again whenever the watched input file changes, until ctx is done.
+func watch[T comparable](ctx context.Context, opts *runOptions, challenges []ChallengeE[T]) ([]Result[T], error) {
+	path, err := opts.watchedPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result[T]
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for last, changed := statFile(path), true; ; {
+		if changed {
+			if results, err = execute(ctx, opts, challenges); err != nil {
+				opts.reportWatchError(err)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return results, context.Cause(ctx)
+		}
+
+		state := statFile(path)
+		changed, last = state != last, state
+	}
+}
+
+// watchedPath returns the path of the input file watched by WithWatch: the sample in sample mode, or else
+// the file of WithInputFile.
+func (o *runOptions) watchedPath(ctx context.Context) (string, error) {
+	if o.sample {
+		return o.samplePath(ctx)
+	}
+
+	if o.inputPath == "" {
+		return "", ErrNothingToWatch
+	}
+
+	return o.inputPath, nil
+}
+
+// reportWatchError passes the error of a watched run to the WithOnError handlers, or writes it to stderr.
+func (o *runOptions) reportWatchError(err error) {
+	if len(o.onError) == 0 {
+		fmt.Fprintf(os.Stderr, "goaoc: %v\n", err)
+
+		return
+	}
+
+	for _, handle := range o.onError {
+		handle(err)
+	}
+}
+
+// fileState identifies a version of a file by its modification time and size.
+type fileState struct {
+	modTime time.Time
+	size    int64
+	exists  bool
+}
+
+// statFile returns the state of the file at path. A file that cannot be read is reported as missing.
+func statFile(path string) fileState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileState{}
+	}
+
+	return fileState{modTime: info.ModTime(), size: info.Size(), exists: true}
+}
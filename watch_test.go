@@ -0,0 +1,162 @@
+// Copyright (c) 2024 Highlander Paiva. All rights reserved.
+// Use of this source code is governed by the MIT License that can be found in
+// the LICENSE file.
+
+package goaoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithWatch(t *testing.T) {
+	interval := watchInterval
+	watchInterval = 5 * time.Millisecond
+
+	t.Cleanup(func() { watchInterval = interval })
+
+	path := filepath.Join(t.TempDir(), "input.txt")
+	writeFile(t, path, "a")
+
+	var (
+		mu      sync.Mutex
+		answers []int
+		errs    []error
+	)
+
+	answered := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(answers) + len(errs)
+	}
+
+	waitFor := func(count int) {
+		t.Helper()
+
+		for deadline := time.Now().Add(5 * time.Second); answered() < count; time.Sleep(time.Millisecond) {
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected %d runs, but got %d", count, answered())
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() {
+		_, err := run(ctx, withErrors(func(input string) int { return len(input) }, nil),
+			WithPart(1),
+			WithWriter(writerFunc(func(string) error { return nil })),
+			WithInputFile(path),
+			WithWatch(),
+			WithAfterRun(func(result Result[int]) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				answers = append(answers, result.Answer)
+			}),
+			WithOnError(func(err error) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				errs = append(errs, err)
+			}))
+		done <- err
+	}()
+
+	waitFor(1)
+	writeFile(t, path, "abc")
+	waitFor(2)
+
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("Unexpected error removing the input: %v", err)
+	}
+
+	waitFor(3)
+	cancel()
+
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the watch to stop with the context, but got: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(answers) != 2 || answers[0] != 1 || answers[1] != 3 {
+		t.Errorf("Expected answers [1 3], but got %v", answers)
+	}
+
+	if len(errs) == 0 || !errors.Is(errs[0], ErrInputFileNotFound) {
+		t.Errorf("Expected the missing input to be reported, but got %v", errs)
+	}
+}
+
+func TestWithWatchWithoutFile(t *testing.T) {
+	_, err := run(context.Background(), withErrors(func(input string) int { return len(input) }, nil),
+		WithPart(1), WithInputString("a"), WithWatch())
+	if !errors.Is(err, ErrNothingToWatch) {
+		t.Errorf("Expected ErrNothingToWatch, but got: %v", err)
+	}
+}
+
+func TestWatchSubmitsAnswersOnce(t *testing.T) {
+	submissions := 0
+	server := newAnswerServer(t, &submissions)
+	stdout := new(bytes.Buffer)
+	opts := runOptions{
+		writer: DefaultConsoleManager{Env: mockEnv(nil, "", stdout)}, year: 2024, day: 7, watch: true, submitMode: NoConfirm,
+		baseURL: server.URL, session: "secret", cacheDir: t.TempDir(),
+	}
+
+	for range 3 {
+		if err := opts.submitAnswer(context.Background(), 2, "3749"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if submissions != 1 || strings.Count(stdout.String(), "Part 2: correct") != 1 {
+		t.Errorf("Expected the answer to be submitted once while watching, but got %d submissions and %q", submissions, stdout.String())
+	}
+}
+
+// writerFunc is an OutputWriter writing with a function.
+type writerFunc func(result string) error
+
+// Write calls f(result).
+func (f writerFunc) Write(result string) error {
+	return f(result)
+}
+
+func TestWithWatchAfterInputOption(t *testing.T) {
+	provider := InputProviderFunc(func(context.Context, int, int) (string, error) { return "a", nil })
+
+	testCases := map[string]RunOption{
+		"Provider":  WithInputProvider(provider, 2024, 7),
+		"Stdin":     WithInputStdin(),
+		"Download":  WithInputDownload(2024, 7),
+		"URL":       WithInputURL("https://aoc.example.com/2024/7", nil),
+		"Generated": WithGeneratedInput(func(int64) string { return "a" }),
+		"SSH":       WithInputSSH("ssh://devbox/input.txt"),
+	}
+
+	for name, input := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var opts runOptions
+			if err := applyOptions(&opts, WithInputFile("input.txt"), input); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if _, err := opts.watchedPath(context.Background()); !errors.Is(err, ErrNothingToWatch) {
+				t.Errorf("Expected the file of the replaced input not to be watched, but got: %v", err)
+			}
+		})
+	}
+}